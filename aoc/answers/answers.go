@@ -0,0 +1,123 @@
+// A local store of known answers, keyed by day, part and a hash of the input,
+// so results can be checked after any change to a solution.
+package answers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"sort"
+)
+
+// A known answer for the given input of a day and part
+type KnownAnswer struct {
+	Day       int    `json:"day"`
+	Part      int    `json:"part"`
+	InputHash string `json:"inputHash"`
+	// The path of the input the answer was recorded from, for reference only
+	InputName string `json:"inputName"`
+	Answer    string `json:"answer"`
+}
+
+type answerKey struct {
+	day       int
+	part      int
+	inputHash string
+}
+
+type AnswerStore struct {
+	answers map[answerKey]KnownAnswer
+}
+
+type answerFileData struct {
+	Answers []KnownAnswer `json:"answers"`
+}
+
+// Hash the contents of an input, for use as part of the key of an answer
+func HashInput(input []byte) string {
+	hash := sha256.Sum256(input)
+	return hex.EncodeToString(hash[:])
+}
+
+// Load the answer store from the given path
+//
+// A missing file is treated as an empty store, so the first answers can be recorded.
+func Load(path string) (*AnswerStore, error) {
+	store := &AnswerStore{
+		answers: make(map[answerKey]KnownAnswer),
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var fileData answerFileData
+	if err := json.Unmarshal(data, &fileData); err != nil {
+		return nil, err
+	}
+	for _, knownAnswer := range fileData.Answers {
+		store.answers[answerKey{knownAnswer.Day, knownAnswer.Part, knownAnswer.InputHash}] = knownAnswer
+	}
+	return store, nil
+}
+
+// Save the answer store to the given path, sorted by day, part then input name
+func (store *AnswerStore) Save(path string) error {
+	fileData := answerFileData{
+		Answers: make([]KnownAnswer, 0, len(store.answers)),
+	}
+	for _, knownAnswer := range store.answers {
+		fileData.Answers = append(fileData.Answers, knownAnswer)
+	}
+	sort.Slice(fileData.Answers, func(i, j int) bool {
+		a, b := fileData.Answers[i], fileData.Answers[j]
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+		if a.Part != b.Part {
+			return a.Part < b.Part
+		}
+		if a.InputName != b.InputName {
+			return a.InputName < b.InputName
+		}
+		return a.InputHash < b.InputHash
+	})
+
+	data, err := json.MarshalIndent(fileData, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// Check an answer against the known answer for the same day, part and input
+//
+// Returns the known answer if one exists
+func (store *AnswerStore) Verify(day int, part int, inputHash string, answer string) (VerificationStatusEnum, string) {
+	knownAnswer, ok := store.answers[answerKey{day, part, inputHash}]
+	if !ok {
+		return VERIFICATION_NEW, ""
+	}
+	if knownAnswer.Answer != answer {
+		return VERIFICATION_FAIL, knownAnswer.Answer
+	}
+	return VERIFICATION_PASS, knownAnswer.Answer
+}
+
+// Record an answer, replacing any known answer for the same day, part and input
+func (store *AnswerStore) Record(day int, part int, inputHash string, inputName string, answer string) {
+	store.answers[answerKey{day, part, inputHash}] = KnownAnswer{
+		Day:       day,
+		Part:      part,
+		InputHash: inputHash,
+		InputName: inputName,
+		Answer:    answer,
+	}
+}
@@ -0,0 +1,50 @@
+package answers
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestAnswerStoreRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.json")
+
+	store, err := Load(path)
+	if err != nil {
+		t.Fatalf("error loading missing store: %v", err)
+	}
+
+	inputHash := HashInput([]byte("example input"))
+	if status, _ := store.Verify(17, 1, inputHash, "102"); status != VERIFICATION_NEW {
+		t.Errorf("got %v before recording, expected %v", status, VERIFICATION_NEW)
+	}
+
+	store.Record(17, 1, inputHash, "example1.txt", "102")
+	if err := store.Save(path); err != nil {
+		t.Fatalf("error saving store: %v", err)
+	}
+
+	store, err = Load(path)
+	if err != nil {
+		t.Fatalf("error loading saved store: %v", err)
+	}
+
+	testCases := []struct {
+		day      int
+		part     int
+		answer   string
+		expected VerificationStatusEnum
+	}{
+		{17, 1, "102", VERIFICATION_PASS},
+		{17, 1, "103", VERIFICATION_FAIL},
+		{17, 2, "102", VERIFICATION_NEW},
+	}
+	for _, testCase := range testCases {
+		status, knownAnswer := store.Verify(testCase.day, testCase.part, inputHash, testCase.answer)
+		if status != testCase.expected {
+			t.Errorf("day %v part %v answer %v: got %v, expected %v", testCase.day, testCase.part, testCase.answer, status, testCase.expected)
+		}
+		if status != VERIFICATION_NEW && knownAnswer != "102" {
+			t.Errorf("got known answer %v, expected 102", knownAnswer)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package answers
+
+//go:generate stringer -type=VerificationStatusEnum -linecomment
+type VerificationStatusEnum int
+
+const (
+	VERIFICATION_PASS VerificationStatusEnum = iota // PASS
+	VERIFICATION_FAIL VerificationStatusEnum = iota // FAIL
+	VERIFICATION_NEW  VerificationStatusEnum = iota // NEW
+)
@@ -0,0 +1,26 @@
+// Code generated by "stringer -type=VerificationStatusEnum -linecomment"; DO NOT EDIT.
+
+package answers
+
+import "strconv"
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[VERIFICATION_PASS-0]
+	_ = x[VERIFICATION_FAIL-1]
+	_ = x[VERIFICATION_NEW-2]
+}
+
+const _VerificationStatusEnum_name = "PASSFAILNEW"
+
+var _VerificationStatusEnum_index = [...]uint8{0, 4, 8, 11}
+
+func (i VerificationStatusEnum) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_VerificationStatusEnum_index)-1 {
+		return "VerificationStatusEnum(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _VerificationStatusEnum_name[_VerificationStatusEnum_index[idx]:_VerificationStatusEnum_index[idx+1]]
+}
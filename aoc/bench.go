@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"hmcalister/aoc/benchreport"
+	"hmcalister/aoc/registry"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/rs/zerolog/log"
+)
+
+func benchCommand(args []string) {
+	flags := flag.NewFlagSet("bench", flag.ExitOnError)
+	dayFlag := flags.Int("day", 0, "Day to benchmark. Benchmarks all days if not given")
+	solutionsDirFlag := flags.String("solutionsDir", "solutions", "Directory containing the solution for each day")
+	benchtimeFlag := flags.String("benchtime", "1s", "Run time (or iterations, e.g. 100x) of each benchmark, passed to go test")
+	saveFlag := flags.String("save", "", "Path to save the report to as JSON, for later comparison with benchdiff")
+	logConfig := registerLoggingFlags(flags)
+	flags.Parse(args)
+
+	configureLogging(logConfig, os.Stdout)
+
+	days := make([]int, 0)
+	for _, key := range registry.Keys() {
+		if (*dayFlag == 0 || key.Day == *dayFlag) && (len(days) == 0 || days[len(days)-1] != key.Day) {
+			days = append(days, key.Day)
+		}
+	}
+	if len(days) == 0 {
+		log.Fatal().Msgf("no solvers registered for day %v", *dayFlag)
+	}
+
+	report := benchreport.Report{}
+	for _, day := range days {
+		dayDir := filepath.Join(*solutionsDirFlag, fmt.Sprintf("%02d", day))
+		log.Info().Int("Day", day).Msg("Benchmarking")
+
+		var benchOutput bytes.Buffer
+		cmd := exec.Command("go", "test", "-run", "^$", "-bench", ".", "-benchmem", "-benchtime", *benchtimeFlag, "./...")
+		cmd.Dir = dayDir
+		cmd.Stdout = &benchOutput
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			log.Error().Int("Day", day).Err(err).Msg("error running benchmarks")
+			continue
+		}
+
+		results, err := benchreport.ParseBenchOutput(&benchOutput)
+		if err != nil {
+			log.Error().Int("Day", day).Err(err).Msg("error parsing benchmark output")
+			continue
+		}
+		report.Results = append(report.Results, results...)
+	}
+	report.Sort()
+
+	if err := report.WriteTable(os.Stdout); err != nil {
+		log.Fatal().Msgf("error writing report: %v", err)
+	}
+
+	if *saveFlag != "" {
+		if err := report.Save(*saveFlag); err != nil {
+			log.Fatal().Msgf("error saving report: %v", err)
+		}
+	}
+}
+
+func benchDiffCommand(args []string) {
+	flags := flag.NewFlagSet("benchdiff", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: aoc benchdiff <old report> <new report>")
+		flags.PrintDefaults()
+	}
+	logConfig := registerLoggingFlags(flags)
+	flags.Parse(args)
+
+	configureLogging(logConfig, os.Stdout)
+
+	if flags.NArg() != 2 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	oldReport, err := benchreport.Load(flags.Arg(0))
+	if err != nil {
+		log.Fatal().Msgf("error loading old report: %v", err)
+	}
+	newReport, err := benchreport.Load(flags.Arg(1))
+	if err != nil {
+		log.Fatal().Msgf("error loading new report: %v", err)
+	}
+
+	if err := benchreport.WriteDiffTable(os.Stdout, oldReport, newReport); err != nil {
+		log.Fatal().Msgf("error writing report: %v", err)
+	}
+}
@@ -0,0 +1,192 @@
+// Collect the output of "go test -bench" across days into a single report,
+// which can be saved and compared against earlier reports.
+package benchreport
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// The measurements of a single benchmark
+type Result struct {
+	Package     string  `json:"package"`
+	Name        string  `json:"name"`
+	Iterations  int     `json:"iterations"`
+	NsPerOp     float64 `json:"nsPerOp"`
+	BytesPerOp  int64   `json:"bytesPerOp"`
+	AllocsPerOp int64   `json:"allocsPerOp"`
+}
+
+// Uniquely identifies a benchmark across reports
+func (result Result) Key() string {
+	return result.Package + "." + result.Name
+}
+
+type Report struct {
+	Results []Result `json:"results"`
+}
+
+// Matches the trailing GOMAXPROCS suffix go test adds to benchmark names
+var procsSuffixRegex = regexp.MustCompile(`-\d+$`)
+
+// Parse the (possibly concatenated) output of "go test -bench -benchmem" into results
+//
+// Lines that are not benchmark results are ignored.
+func ParseBenchOutput(r io.Reader) ([]Result, error) {
+	results := make([]Result, 0)
+	currentPackage := ""
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "pkg: ") {
+			currentPackage = strings.TrimPrefix(line, "pkg: ")
+			continue
+		}
+		if !strings.HasPrefix(line, "Benchmark") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		// A result line is the name, iterations then pairs of value and unit
+		if len(fields) < 4 || len(fields)%2 != 0 {
+			continue
+		}
+		iterations, err := strconv.Atoi(fields[1])
+		if err != nil {
+			continue
+		}
+
+		result := Result{
+			Package:    currentPackage,
+			Name:       procsSuffixRegex.ReplaceAllString(fields[0], ""),
+			Iterations: iterations,
+		}
+		for i := 2; i < len(fields); i += 2 {
+			value, unit := fields[i], fields[i+1]
+			switch unit {
+			case "ns/op":
+				result.NsPerOp, err = strconv.ParseFloat(value, 64)
+			case "B/op":
+				result.BytesPerOp, err = strconv.ParseInt(value, 10, 64)
+			case "allocs/op":
+				result.AllocsPerOp, err = strconv.ParseInt(value, 10, 64)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("error parsing %v in line %q: %w", unit, line, err)
+			}
+		}
+		results = append(results, result)
+	}
+
+	return results, scanner.Err()
+}
+
+// Sort the results of the report by package then name
+func (report *Report) Sort() {
+	sort.Slice(report.Results, func(i, j int) bool {
+		return report.Results[i].Key() < report.Results[j].Key()
+	})
+}
+
+func (report Report) Save(path string) error {
+	data, err := json.MarshalIndent(report, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+func Load(path string) (Report, error) {
+	var report Report
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return report, err
+	}
+	err = json.Unmarshal(data, &report)
+	return report, err
+}
+
+// Write the report as an aligned table
+func (report Report) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "Package\tBenchmark\tns/op\tB/op\tallocs/op\t")
+	for _, result := range report.Results {
+		fmt.Fprintf(tw, "%v\t%v\t%.0f\t%v\t%v\t\n",
+			result.Package,
+			result.Name,
+			result.NsPerOp,
+			result.BytesPerOp,
+			result.AllocsPerOp,
+		)
+	}
+	return tw.Flush()
+}
+
+// Write a table comparing each benchmark of the old report against the new one
+//
+// Benchmarks present in only one report are listed with blank values for the other.
+func WriteDiffTable(w io.Writer, oldReport Report, newReport Report) error {
+	oldResults := make(map[string]Result)
+	for _, result := range oldReport.Results {
+		oldResults[result.Key()] = result
+	}
+	newResults := make(map[string]Result)
+	for _, result := range newReport.Results {
+		newResults[result.Key()] = result
+	}
+
+	combined := Report{Results: append([]Result{}, oldReport.Results...)}
+	for _, result := range newReport.Results {
+		if _, ok := oldResults[result.Key()]; !ok {
+			combined.Results = append(combined.Results, result)
+		}
+	}
+	combined.Sort()
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "Package\tBenchmark\told ns/op\tnew ns/op\tdelta\told B/op\tnew B/op\tdelta\told allocs/op\tnew allocs/op\tdelta\t")
+	for _, result := range combined.Results {
+		oldResult, inOld := oldResults[result.Key()]
+		newResult, inNew := newResults[result.Key()]
+
+		fmt.Fprintf(tw, "%v\t%v\t", result.Package, result.Name)
+		writeDiffColumns(tw, inOld, inNew, oldResult.NsPerOp, newResult.NsPerOp)
+		writeDiffColumns(tw, inOld, inNew, float64(oldResult.BytesPerOp), float64(newResult.BytesPerOp))
+		writeDiffColumns(tw, inOld, inNew, float64(oldResult.AllocsPerOp), float64(newResult.AllocsPerOp))
+		fmt.Fprintln(tw)
+	}
+	return tw.Flush()
+}
+
+func writeDiffColumns(w io.Writer, inOld bool, inNew bool, oldValue float64, newValue float64) {
+	oldColumn, newColumn, deltaColumn := "-", "-", "-"
+	if inOld {
+		oldColumn = fmt.Sprintf("%.0f", oldValue)
+	}
+	if inNew {
+		newColumn = fmt.Sprintf("%.0f", newValue)
+	}
+	if inOld && inNew {
+		deltaColumn = percentageChange(oldValue, newValue)
+	}
+	fmt.Fprintf(w, "%v\t%v\t%v\t", oldColumn, newColumn, deltaColumn)
+}
+
+func percentageChange(oldValue float64, newValue float64) string {
+	if oldValue == 0 {
+		if newValue == 0 {
+			return "~"
+		}
+		return "+inf%"
+	}
+	return fmt.Sprintf("%+.2f%%", 100*(newValue-oldValue)/oldValue)
+}
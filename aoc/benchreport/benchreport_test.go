@@ -0,0 +1,68 @@
+package benchreport
+
+import (
+	"strings"
+	"testing"
+)
+
+const benchOutput = `goos: linux
+goarch: amd64
+pkg: hmcalister/aoc17/part01
+cpu: Intel(R) Xeon(R) Processor
+BenchmarkProcessInput/example1-8         	     123	   8330012 ns/op	 1526603 B/op	   47138 allocs/op
+PASS
+ok  	hmcalister/aoc17/part01	2.005s
+pkg: hmcalister/aoc17/part02
+BenchmarkProcessInput/example2         	    4795	    236853.5 ns/op	   53930 B/op	    1300 allocs/op
+--- SKIP: BenchmarkProcessInput
+PASS
+`
+
+func TestParseBenchOutput(t *testing.T) {
+	results, err := ParseBenchOutput(strings.NewReader(benchOutput))
+	if err != nil {
+		t.Fatalf("error parsing bench output: %v", err)
+	}
+
+	expected := []Result{
+		{"hmcalister/aoc17/part01", "BenchmarkProcessInput/example1", 123, 8330012, 1526603, 47138},
+		{"hmcalister/aoc17/part02", "BenchmarkProcessInput/example2", 4795, 236853.5, 53930, 1300},
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("got %v results, expected %v", len(results), len(expected))
+	}
+	for i := range expected {
+		if results[i] != expected[i] {
+			t.Errorf("result %v: got %+v, expected %+v", i, results[i], expected[i])
+		}
+	}
+}
+
+func TestWriteDiffTable(t *testing.T) {
+	oldReport := Report{Results: []Result{
+		{Package: "p", Name: "BenchmarkA", NsPerOp: 100, BytesPerOp: 10, AllocsPerOp: 1},
+		{Package: "p", Name: "BenchmarkRemoved", NsPerOp: 100},
+	}}
+	newReport := Report{Results: []Result{
+		{Package: "p", Name: "BenchmarkA", NsPerOp: 150, BytesPerOp: 5, AllocsPerOp: 1},
+		{Package: "p", Name: "BenchmarkAdded", NsPerOp: 100},
+	}}
+
+	var sb strings.Builder
+	if err := WriteDiffTable(&sb, oldReport, newReport); err != nil {
+		t.Fatalf("error writing diff table: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("got %v lines, expected 4:\n%v", len(lines), sb.String())
+	}
+
+	for _, expectedField := range []string{"BenchmarkA", "+50.00%", "-50.00%", "+0.00%"} {
+		if !strings.Contains(lines[1], expectedField) {
+			t.Errorf("expected %q in line %q", expectedField, lines[1])
+		}
+	}
+	if !strings.Contains(lines[2], "BenchmarkAdded") || !strings.Contains(lines[3], "BenchmarkRemoved") {
+		t.Errorf("expected added and removed benchmarks in sorted order:\n%v", sb.String())
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"hmcalister/aoc/fetch"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Download the input and examples of a day, copying the input to the puzzleInput file of the day
+func fetchCommand(args []string) {
+	flags := flag.NewFlagSet("fetch", flag.ExitOnError)
+	dayFlag := flags.Int("day", 0, "Day of the puzzle to fetch (1-25)")
+	solutionsDirFlag := flags.String("solutionsDir", "solutions", "Directory containing the solution for each day")
+	cacheDirFlag := flags.String("cacheDir", ".aoccache", "Directory to cache downloaded inputs and puzzle pages in")
+	baseURLFlag := flags.String("baseURL", fetch.DEFAULT_BASE_URL, "Base URL of the puzzle site")
+	minIntervalFlag := flags.Duration("minInterval", 5*time.Second, "Minimum time between requests to the puzzle site")
+	logConfig := registerLoggingFlags(flags)
+	flags.Parse(args)
+
+	configureLogging(logConfig, os.Stdout)
+
+	client := fetch.NewClient(*baseURLFlag, os.Getenv(fetch.SESSION_ENV_VARIABLE), *cacheDirFlag, *minIntervalFlag)
+
+	input, err := client.Input(*dayFlag)
+	if err != nil {
+		log.Fatal().Msgf("error fetching input: %v", err)
+	}
+
+	// Never overwrite an input placed by hand
+	inputPath := filepath.Join(*solutionsDirFlag, fmt.Sprintf("%02d", *dayFlag), INPUT_FILE_PATH)
+	if _, err := os.Stat(inputPath); errors.Is(err, fs.ErrNotExist) {
+		if err := os.MkdirAll(filepath.Dir(inputPath), 0755); err != nil {
+			log.Fatal().Msgf("error creating day directory: %v", err)
+		}
+		if err := os.WriteFile(inputPath, input, 0644); err != nil {
+			log.Fatal().Msgf("error writing input: %v", err)
+		}
+		log.Info().Str("Input", inputPath).Msg("wrote puzzle input")
+	} else {
+		log.Info().Str("Input", inputPath).Msg("puzzle input already present, leaving it unchanged")
+	}
+
+	page, err := client.PuzzlePage(*dayFlag)
+	if err != nil {
+		log.Fatal().Msgf("error fetching puzzle page: %v", err)
+	}
+	for exampleIndex, example := range fetch.ExtractExamples(page) {
+		examplePath := filepath.Join(client.DayDir(*dayFlag), fmt.Sprintf("example%v.txt", exampleIndex+1))
+		if err := os.WriteFile(examplePath, []byte(example), 0644); err != nil {
+			log.Fatal().Msgf("error writing example: %v", err)
+		}
+		log.Info().Str("Example", examplePath).Int("NumLines", strings.Count(example, "\n")).Msg("extracted code block")
+	}
+}
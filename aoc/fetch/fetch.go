@@ -0,0 +1,171 @@
+// Downloading of puzzle inputs and pages, cached on disk so each is only ever requested once.
+package fetch
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	YEAR = 2023
+
+	DEFAULT_BASE_URL     = "https://adventofcode.com"
+	SESSION_ENV_VARIABLE = "AOC_SESSION"
+	USER_AGENT           = "github.com/hmcalister/Advent-Of-Code-2023 aoc fetch"
+
+	INPUT_FILE_NAME        = "input.txt"
+	PUZZLE_PAGE_FILE_NAME  = "puzzle.html"
+	LAST_REQUEST_FILE_NAME = "lastRequest"
+)
+
+var (
+	exampleBlockRegexp = regexp.MustCompile(`(?s)<pre><code>(.*?)</code></pre>`)
+	htmlTagRegexp      = regexp.MustCompile(`<[^>]*>`)
+)
+
+// A client for the puzzle site, caching every response under CacheDir
+//
+// Requests are spaced at least MinInterval apart, including requests made by earlier runs,
+// as the time of the last request is kept in the cache directory
+type Client struct {
+	BaseURL      string
+	SessionToken string
+	CacheDir     string
+	MinInterval  time.Duration
+	HTTPClient   *http.Client
+
+	// Replaceable for testing
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+func NewClient(baseURL string, sessionToken string, cacheDir string, minInterval time.Duration) *Client {
+	return &Client{
+		BaseURL:      strings.TrimSuffix(baseURL, "/"),
+		SessionToken: sessionToken,
+		CacheDir:     cacheDir,
+		MinInterval:  minInterval,
+		HTTPClient:   &http.Client{Timeout: 30 * time.Second},
+		now:          time.Now,
+		sleep:        time.Sleep,
+	}
+}
+
+// Get the puzzle input of a day, from the cache if it has been downloaded before
+func (client *Client) Input(day int) ([]byte, error) {
+	return client.cachedGet(day, INPUT_FILE_NAME, fmt.Sprintf("/%v/day/%v/input", YEAR, day))
+}
+
+// Get the puzzle page of a day, from the cache if it has been downloaded before
+//
+// The page only holds part two once part one is solved, so delete the cached page to download it again
+func (client *Client) PuzzlePage(day int) ([]byte, error) {
+	return client.cachedGet(day, PUZZLE_PAGE_FILE_NAME, fmt.Sprintf("/%v/day/%v", YEAR, day))
+}
+
+// The directory of the cache holding the files of a day
+func (client *Client) DayDir(day int) string {
+	return filepath.Join(client.CacheDir, fmt.Sprintf("%02d", day))
+}
+
+func (client *Client) cachedGet(day int, fileName string, urlPath string) ([]byte, error) {
+	if day < 1 || day > 25 {
+		return nil, fmt.Errorf("day %v is not between 1 and 25", day)
+	}
+
+	cachePath := filepath.Join(client.DayDir(day), fileName)
+	data, err := os.ReadFile(cachePath)
+	if err == nil {
+		return data, nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	data, err = client.get(urlPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(client.DayDir(day), 0755); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(cachePath, data, 0644); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (client *Client) get(urlPath string) ([]byte, error) {
+	if client.SessionToken == "" {
+		return nil, fmt.Errorf("no session token, set %v to the session cookie of the puzzle site", SESSION_ENV_VARIABLE)
+	}
+
+	if err := client.waitForRateLimit(); err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest(http.MethodGet, client.BaseURL+urlPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("User-Agent", USER_AGENT)
+	request.AddCookie(&http.Cookie{Name: "session", Value: client.SessionToken})
+
+	response, err := client.HTTPClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("request for %v failed with status %v: %v", urlPath, response.Status, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+// Sleep until MinInterval has passed since the last request, then record this request as the last
+func (client *Client) waitForRateLimit() error {
+	if err := os.MkdirAll(client.CacheDir, 0755); err != nil {
+		return err
+	}
+	lastRequestPath := filepath.Join(client.CacheDir, LAST_REQUEST_FILE_NAME)
+
+	if data, err := os.ReadFile(lastRequestPath); err == nil {
+		lastRequestNs, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+		if err == nil {
+			wait := time.Unix(0, lastRequestNs).Add(client.MinInterval).Sub(client.now())
+			if wait > 0 {
+				client.sleep(wait)
+			}
+		}
+	}
+
+	return os.WriteFile(lastRequestPath, []byte(strconv.FormatInt(client.now().UnixNano(), 10)), 0644)
+}
+
+// Extract the text of every code block of a puzzle page, in order
+//
+// Examples are given in such blocks, although not every block is an example
+func ExtractExamples(page []byte) []string {
+	examples := make([]string, 0)
+	for _, match := range exampleBlockRegexp.FindAllSubmatch(page, -1) {
+		text := htmlTagRegexp.ReplaceAllString(string(match[1]), "")
+		examples = append(examples, html.UnescapeString(text))
+	}
+	return examples
+}
@@ -0,0 +1,130 @@
+package fetch
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+const PUZZLE_PAGE = `<article><p>For example:</p>
+<pre><code>467..114..
+...*......
+</code></pre>
+<p>The sum is <code>4361</code>, but <pre><code><em>a &lt; b</em> &amp; c
+</code></pre></p></article>`
+
+func newTestServer(t *testing.T, requestCount *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requestCount += 1
+		cookie, err := r.Cookie("session")
+		if err != nil || cookie.Value != "token" {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Path {
+		case "/2023/day/3/input":
+			w.Write([]byte("467..114..\n"))
+		case "/2023/day/3":
+			w.Write([]byte(PUZZLE_PAGE))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func newTestClient(baseURL string, cacheDir string, token string) (*Client, *time.Time, *time.Duration) {
+	client := NewClient(baseURL, token, cacheDir, 5*time.Second)
+	currentTime := time.Unix(1700000000, 0)
+	var slept time.Duration
+	client.now = func() time.Time { return currentTime }
+	client.sleep = func(d time.Duration) {
+		slept += d
+		currentTime = currentTime.Add(d)
+	}
+	return client, &currentTime, &slept
+}
+
+func TestInputIsCached(t *testing.T) {
+	requestCount := 0
+	server := newTestServer(t, &requestCount)
+	defer server.Close()
+
+	client, _, _ := newTestClient(server.URL, t.TempDir(), "token")
+	for i := 0; i < 3; i += 1 {
+		input, err := client.Input(3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(input) != "467..114..\n" {
+			t.Errorf("got input %q", input)
+		}
+	}
+	if requestCount != 1 {
+		t.Errorf("got %v requests, expected 1", requestCount)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	requestCount := 0
+	server := newTestServer(t, &requestCount)
+	defer server.Close()
+
+	cacheDir := t.TempDir()
+	client, currentTime, slept := newTestClient(server.URL, cacheDir, "token")
+	if _, err := client.Input(3); err != nil {
+		t.Fatal(err)
+	}
+	*currentTime = currentTime.Add(2 * time.Second)
+
+	// A new client shares the time of the last request through the cache directory
+	secondClient, _, _ := newTestClient(server.URL, cacheDir, "token")
+	secondClient.now = client.now
+	secondClient.sleep = client.sleep
+	if _, err := secondClient.PuzzlePage(3); err != nil {
+		t.Fatal(err)
+	}
+	if *slept != 3*time.Second {
+		t.Errorf("slept for %v, expected 3s", *slept)
+	}
+}
+
+func TestErrorsAreNotCached(t *testing.T) {
+	requestCount := 0
+	server := newTestServer(t, &requestCount)
+	defer server.Close()
+
+	cacheDir := t.TempDir()
+	client, _, _ := newTestClient(server.URL, cacheDir, "wrong")
+	if _, err := client.Input(3); err == nil {
+		t.Errorf("expected error for an invalid session")
+	}
+	if _, err := client.Input(4); err == nil {
+		t.Errorf("expected error for a missing day")
+	}
+	if _, err := client.Input(26); err == nil {
+		t.Errorf("expected error for an invalid day")
+	}
+
+	client, _, _ = newTestClient(server.URL, cacheDir, "")
+	if _, err := client.Input(3); err == nil {
+		t.Errorf("expected error without a session token")
+	}
+
+	client, _, _ = newTestClient(server.URL, cacheDir, "token")
+	if _, err := client.Input(3); err != nil {
+		t.Errorf("expected input once the session is valid, got %v", err)
+	}
+	if requestCount != 3 {
+		t.Errorf("got %v requests, expected 3", requestCount)
+	}
+}
+
+func TestExtractExamples(t *testing.T) {
+	examples := ExtractExamples([]byte(PUZZLE_PAGE))
+	expected := []string{"467..114..\n...*......\n", "a < b & c\n"}
+	if !reflect.DeepEqual(examples, expected) {
+		t.Errorf("got examples %q, expected %q", examples, expected)
+	}
+}
@@ -0,0 +1,77 @@
+module hmcalister/aoc
+
+go 1.21.0
+
+require (
+	github.com/rs/zerolog v1.31.0
+	hmcalister/aoc01 v0.0.0
+	hmcalister/aoc02 v0.0.0
+	hmcalister/aoc03 v0.0.0
+	hmcalister/aoc04 v0.0.0
+	hmcalister/aoc05 v0.0.0
+	hmcalister/aoc06 v0.0.0
+	hmcalister/aoc07 v0.0.0
+	hmcalister/aoc08 v0.0.0
+	hmcalister/aoc09 v0.0.0
+	hmcalister/aoc10 v0.0.0
+	hmcalister/aoc11 v0.0.0
+	hmcalister/aoc12 v0.0.0
+	hmcalister/aoc13 v0.0.0
+	hmcalister/aoc14 v0.0.0
+	hmcalister/aoc15 v0.0.0
+	hmcalister/aoc16 v0.0.0
+	hmcalister/aoc17 v0.0.0
+	hmcalister/aoc18 v0.0.0
+	hmcalister/aoc19 v0.0.0
+	hmcalister/aoc20 v0.0.0
+	hmcalister/aoc21 v0.0.0
+	hmcalister/aoc23 v0.0.0
+	hmcalister/aoc24 v0.0.0
+	hmcalister/aoc25 v0.0.0
+	hmcalister/aocCommon v0.0.0
+	hmcalister/aox22 v0.0.0
+)
+
+require (
+	github.com/dominikbraun/graph v0.23.0 // indirect
+	github.com/mattn/go-colorable v0.1.13 // indirect
+	github.com/mattn/go-isatty v0.0.20 // indirect
+	github.com/mitchellh/colorstring v0.0.0-20190213212951-d06e56a500db // indirect
+	github.com/openacid/slimarray v0.1.3 // indirect
+	github.com/rivo/uniseg v0.4.4 // indirect
+	github.com/schollz/progressbar/v3 v3.14.1 // indirect
+	golang.org/x/sync v0.5.0 // indirect
+	golang.org/x/sys v0.15.0 // indirect
+	golang.org/x/term v0.14.0 // indirect
+	gonum.org/v1/gonum v0.14.0 // indirect
+)
+
+replace (
+	hmcalister/aoc01 => ../solutions/01
+	hmcalister/aoc02 => ../solutions/02
+	hmcalister/aoc03 => ../solutions/03
+	hmcalister/aoc04 => ../solutions/04
+	hmcalister/aoc05 => ../solutions/05
+	hmcalister/aoc06 => ../solutions/06
+	hmcalister/aoc07 => ../solutions/07
+	hmcalister/aoc08 => ../solutions/08
+	hmcalister/aoc09 => ../solutions/09
+	hmcalister/aoc10 => ../solutions/10
+	hmcalister/aoc11 => ../solutions/11
+	hmcalister/aoc12 => ../solutions/12
+	hmcalister/aoc13 => ../solutions/13
+	hmcalister/aoc14 => ../solutions/14
+	hmcalister/aoc15 => ../solutions/15
+	hmcalister/aoc16 => ../solutions/16
+	hmcalister/aoc17 => ../solutions/17
+	hmcalister/aoc18 => ../solutions/18
+	hmcalister/aoc19 => ../solutions/19
+	hmcalister/aoc20 => ../solutions/20
+	hmcalister/aoc21 => ../solutions/21
+	hmcalister/aoc23 => ../solutions/23
+	hmcalister/aoc24 => ../solutions/24
+	hmcalister/aoc25 => ../solutions/25
+	hmcalister/aox22 => ../solutions/22
+)
+
+replace hmcalister/aocCommon => ../common
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"hmcalister/aoc/registry"
+	"hmcalister/aocCommon/input"
+	"hmcalister/aocCommon/parse"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const STDIN_INPUT_PATH = "-"
+
+// A flag that may be given several times, collecting each value in order
+type inputPathsFlag []string
+
+func (f *inputPathsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *inputPathsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// Get the input paths given by flag and as remaining arguments, falling back to the puzzleInput file of the day
+func resolveInputPaths(inputPaths inputPathsFlag, args []string, solutionsDir string, day int) []string {
+	resolvedPaths := append([]string{}, inputPaths...)
+	resolvedPaths = append(resolvedPaths, args...)
+	if len(resolvedPaths) == 0 {
+		resolvedPaths = append(resolvedPaths, filepath.Join(solutionsDir, fmt.Sprintf("%02d", day), INPUT_FILE_PATH))
+	}
+	return resolvedPaths
+}
+
+// Check the input with the validator registered for the day, if there is one
+func validateInput(day int, puzzleInput []byte) []*parse.ParseError {
+	validator, err := registry.LookupValidator(day)
+	if err != nil {
+		return nil
+	}
+	violations := validator(input.NewScanner(bytes.NewReader(puzzleInput)))
+
+	// Report violations in the order they appear in the input, followed by those of the input as a whole
+	sort.SliceStable(violations, func(i, j int) bool {
+		if violations[i].Line != violations[j].Line {
+			return violations[j].Line == 0 || (violations[i].Line != 0 && violations[i].Line < violations[j].Line)
+		}
+		return violations[i].Column < violations[j].Column
+	})
+	return violations
+}
+
+// Read the whole input at the given path, treating "-" as stdin
+func readInput(inputPath string) ([]byte, error) {
+	if inputPath == STDIN_INPUT_PATH {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(inputPath)
+}
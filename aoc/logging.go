@@ -0,0 +1,22 @@
+package main
+
+import (
+	"flag"
+	"hmcalister/aocCommon/logging"
+	"io"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+// Add the logging flags to the flag set of a command, logging at info level by default
+func registerLoggingFlags(flags *flag.FlagSet) *logging.Config {
+	return logging.RegisterFlags(flags, zerolog.InfoLevel)
+}
+
+// Log to the console writer given, or to the log file if one is set
+func configureLogging(config *logging.Config, console io.Writer) {
+	if err := config.Configure(console); err != nil {
+		log.Fatal().Msgf("error configuring logging: %v", err)
+	}
+}
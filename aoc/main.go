@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+const USAGE = `Usage: aoc <command> [flags]
+
+Commands:
+	run		Run the solver for a given day and part
+	validate	Check the structure of the puzzle input of a given day
+	fetch		Download the puzzle input and examples of a given day
+	new		Create the directory of a new day from solutions/TEMPLATE
+	bench		Benchmark each day and part, reporting the results as a table
+	benchdiff	Compare two reports saved by bench
+	serve		Serve the solvers over HTTP, solving inputs posted to /solve/{day}/{part}
+
+Use "aoc <command> -h" for the flags of each command.
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, USAGE)
+		os.Exit(2)
+	}
+
+	command, args := os.Args[1], os.Args[2:]
+	switch command {
+	case "run":
+		runCommand(args)
+	case "validate":
+		validateCommand(args)
+	case "fetch":
+		fetchCommand(args)
+	case "new":
+		newCommand(args)
+	case "bench":
+		benchCommand(args)
+	case "benchdiff":
+		benchDiffCommand(args)
+	case "serve":
+		serveCommand(args)
+	case "-h", "-help", "--help", "help":
+		fmt.Fprint(os.Stdout, USAGE)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%v", command, USAGE)
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"flag"
+	"hmcalister/aoc/scaffold"
+	"os"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Create the directory of a new day from the template
+func newCommand(args []string) {
+	flags := flag.NewFlagSet("new", flag.ExitOnError)
+	dayFlag := flags.Int("day", 0, "Day of the puzzle to create a solution for (1-25)")
+	solutionsDirFlag := flags.String("solutionsDir", "solutions", "Directory containing the solution for each day, and the TEMPLATE directory")
+	logConfig := registerLoggingFlags(flags)
+	flags.Parse(args)
+
+	configureLogging(logConfig, os.Stdout)
+
+	dayDir, err := scaffold.Create(*solutionsDirFlag, *dayFlag)
+	if err != nil {
+		log.Fatal().Msgf("error creating day: %v", err)
+	}
+
+	modulePath := scaffold.ModulePath(*dayFlag)
+	log.Info().
+		Str("Directory", dayDir).
+		Str("Module", modulePath).
+		Msg("created day")
+	log.Info().Msgf("to run the day with the runner, add %v to the require and replace directives of aoc/go.mod and register its parts in aoc/registry/registry.go", modulePath)
+}
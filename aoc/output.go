@@ -0,0 +1,178 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"hmcalister/aoc/answers"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	FORMAT_CONSOLE = "console"
+	FORMAT_JSON    = "json"
+	FORMAT_CSV     = "csv"
+)
+
+// The outcome of running a single solver on a single input
+type runResult struct {
+	Day   int    `json:"day"`
+	Part  int    `json:"part"`
+	Input string `json:"input"`
+	// The answer of the solver, empty if an error occurred
+	Result       string `json:"result"`
+	Error        string `json:"error,omitempty"`
+	Verification string `json:"verification,omitempty"`
+	KnownAnswer  string `json:"knownAnswer,omitempty"`
+	// Time taken to read the input. Solvers parse the input as part of ProcessInput,
+	// so this is included in the solve time
+	ParseTimeNs int64 `json:"parseTimeNs"`
+	SolveTimeNs int64 `json:"solveTimeNs"`
+}
+
+var csvHeader = []string{"day", "part", "input", "result", "error", "verification", "knownAnswer", "parseTimeNs", "solveTimeNs"}
+
+func (result runResult) csvRecord() []string {
+	return []string{
+		strconv.Itoa(result.Day),
+		strconv.Itoa(result.Part),
+		result.Input,
+		result.Result,
+		result.Error,
+		result.Verification,
+		result.KnownAnswer,
+		strconv.FormatInt(result.ParseTimeNs, 10),
+		strconv.FormatInt(result.SolveTimeNs, 10),
+	}
+}
+
+type resultWriter interface {
+	Write(result runResult) error
+	Flush() error
+}
+
+func newResultWriter(format string, w io.Writer) (resultWriter, error) {
+	switch format {
+	case FORMAT_CONSOLE:
+		return &consoleResultWriter{}, nil
+	case FORMAT_JSON:
+		return &jsonResultWriter{encoder: json.NewEncoder(w)}, nil
+	case FORMAT_CSV:
+		return &csvResultWriter{writer: csv.NewWriter(w)}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+// Writes results as log lines
+type consoleResultWriter struct{}
+
+func (consoleResultWriter) Write(result runResult) error {
+	verificationFailed := result.Verification == answers.VERIFICATION_FAIL.String()
+
+	event := log.Info()
+	if result.Error != "" || verificationFailed {
+		event = log.Error()
+	}
+	event = event.
+		Int("Day", result.Day).
+		Int("Part", result.Part).
+		Str("Input", result.Input)
+
+	if result.Error != "" {
+		event.Str("error", result.Error).Send()
+		return nil
+	}
+	if verificationFailed {
+		event = event.Str("KnownAnswer", result.KnownAnswer)
+	}
+	event.
+		Str("Result", result.Result).
+		Str("Verification", result.Verification).
+		Send()
+	return nil
+}
+
+func (consoleResultWriter) Flush() error {
+	return nil
+}
+
+// Writes each result as a JSON object on its own line
+type jsonResultWriter struct {
+	encoder *json.Encoder
+}
+
+func (w *jsonResultWriter) Write(result runResult) error {
+	return w.encoder.Encode(result)
+}
+
+func (w *jsonResultWriter) Flush() error {
+	return nil
+}
+
+// Writes each result as a CSV record, preceded by a header on the first write
+type csvResultWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func (w *csvResultWriter) Write(result runResult) error {
+	if !w.headerWritten {
+		if err := w.writer.Write(csvHeader); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+	return w.writer.Write(result.csvRecord())
+}
+
+func (w *csvResultWriter) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// Write a table of the results of a run of every solver, followed by the totals of the run
+func writeSummaryTable(w io.Writer, results []runResult, wallTime time.Duration) error {
+	statusCounts := make(map[string]int)
+	var totalSolveTime time.Duration
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Day\tPart\tResult\tStatus\tSolve time\tError")
+	for _, result := range results {
+		status := result.Verification
+		if result.Error != "" {
+			status = "ERROR"
+		}
+		statusCounts[status] += 1
+
+		solveTime := time.Duration(result.SolveTimeNs)
+		totalSolveTime += solveTime
+		fmt.Fprintf(tw, "%v\t%v\t%v\t%v\t%v\t%v\n",
+			result.Day,
+			result.Part,
+			result.Result,
+			status,
+			solveTime.Round(time.Microsecond),
+			result.Error,
+		)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\n%v solvers: %v PASS, %v FAIL, %v NEW, %v ERROR. Total solve time %v, wall time %v\n",
+		len(results),
+		statusCounts[answers.VERIFICATION_PASS.String()],
+		statusCounts[answers.VERIFICATION_FAIL.String()],
+		statusCounts[answers.VERIFICATION_NEW.String()],
+		statusCounts["ERROR"],
+		totalSolveTime.Round(time.Millisecond),
+		wallTime.Round(time.Millisecond),
+	)
+	return err
+}
@@ -0,0 +1,161 @@
+// Profiling of a single solver call, so the profiles of a slow day show only the work of
+// ProcessInput rather than startup, reading input or verifying answers.
+package profile
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"strings"
+)
+
+// The paths to write each profile to, with an empty path disabling that profile
+type Config struct {
+	CPUProfile   string
+	MemProfile   string
+	BlockProfile string
+	Trace        string
+}
+
+// Add the -cpuprofile, -memprofile, -blockprofile and -trace flags to the flag set
+func RegisterFlags(flags *flag.FlagSet) *Config {
+	config := &Config{}
+	flags.StringVar(&config.CPUProfile, "cpuprofile", "", "Write a CPU profile of solving each input to this path")
+	flags.StringVar(&config.MemProfile, "memprofile", "", "Write a heap profile, taken once solving each input has finished, to this path, and one taken before solving to the path with .base before the extension")
+	flags.StringVar(&config.BlockProfile, "blockprofile", "", "Write a goroutine blocking profile of solving each input to this path")
+	flags.StringVar(&config.Trace, "trace", "", "Write an execution trace of solving each input to this path")
+	return config
+}
+
+func (config *Config) Enabled() bool {
+	return config.CPUProfile != "" || config.MemProfile != "" || config.BlockProfile != "" || config.Trace != ""
+}
+
+// Profiles of one solver call, started by Config.Start and written by Stop
+type Session struct {
+	config  Config
+	cpuFile *os.File
+	trace   *os.File
+}
+
+// Start each profile enabled in the config
+//
+// The index is inserted before the extension of each path if it is non-zero, so profiles of
+// several inputs in one run do not overwrite each other, e.g. cpu.prof becomes cpu.2.prof
+//
+// The heap profile counts allocations since the process started, so a baseline heap profile is
+// written here (mem.prof to mem.base.prof) for pprof -base to subtract from the one written by Stop
+func (config *Config) Start(index int) (*Session, error) {
+	session := &Session{
+		config: Config{
+			CPUProfile:   IndexedPath(config.CPUProfile, index),
+			MemProfile:   IndexedPath(config.MemProfile, index),
+			BlockProfile: IndexedPath(config.BlockProfile, index),
+			Trace:        IndexedPath(config.Trace, index),
+		},
+	}
+
+	if session.config.MemProfile != "" {
+		// Collect garbage first so the heap profile is up to date
+		runtime.GC()
+		if err := writeProfile("heap", basePath(session.config.MemProfile)); err != nil {
+			return nil, err
+		}
+	}
+
+	if session.config.CPUProfile != "" {
+		cpuFile, err := os.Create(session.config.CPUProfile)
+		if err != nil {
+			return nil, fmt.Errorf("error creating cpu profile: %w", err)
+		}
+		if err := pprof.StartCPUProfile(cpuFile); err != nil {
+			cpuFile.Close()
+			return nil, fmt.Errorf("error starting cpu profile: %w", err)
+		}
+		session.cpuFile = cpuFile
+	}
+
+	if session.config.Trace != "" {
+		traceFile, err := os.Create(session.config.Trace)
+		if err != nil {
+			session.Stop()
+			return nil, fmt.Errorf("error creating trace: %w", err)
+		}
+		if err := trace.Start(traceFile); err != nil {
+			traceFile.Close()
+			session.Stop()
+			return nil, fmt.Errorf("error starting trace: %w", err)
+		}
+		session.trace = traceFile
+	}
+
+	if session.config.BlockProfile != "" {
+		runtime.SetBlockProfileRate(1)
+	}
+
+	return session, nil
+}
+
+// Stop each profile of the session, writing those that are only taken at the end
+func (session *Session) Stop() error {
+	var errs []error
+
+	if session.cpuFile != nil {
+		pprof.StopCPUProfile()
+		errs = append(errs, session.cpuFile.Close())
+		session.cpuFile = nil
+	}
+
+	if session.trace != nil {
+		trace.Stop()
+		errs = append(errs, session.trace.Close())
+		session.trace = nil
+	}
+
+	if session.config.BlockProfile != "" {
+		errs = append(errs, writeProfile("block", session.config.BlockProfile))
+		runtime.SetBlockProfileRate(0)
+		session.config.BlockProfile = ""
+	}
+
+	if session.config.MemProfile != "" {
+		// Collect garbage first so the heap profile is up to date
+		runtime.GC()
+		errs = append(errs, writeProfile("heap", session.config.MemProfile))
+		session.config.MemProfile = ""
+	}
+
+	return errors.Join(errs...)
+}
+
+func writeProfile(name string, path string) error {
+	profileFile, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error creating %v profile: %w", name, err)
+	}
+	if err := pprof.Lookup(name).WriteTo(profileFile, 0); err != nil {
+		profileFile.Close()
+		return fmt.Errorf("error writing %v profile: %w", name, err)
+	}
+	return profileFile.Close()
+}
+
+// Insert the index before the extension of the path if it is non-zero, e.g. cpu.prof becomes cpu.2.prof,
+// so the outputs of several inputs in one run do not overwrite each other
+func IndexedPath(path string, index int) string {
+	if path == "" || index == 0 {
+		return path
+	}
+	extension := filepath.Ext(path)
+	return fmt.Sprintf("%v.%v%v", strings.TrimSuffix(path, extension), index, extension)
+}
+
+func basePath(path string) string {
+	extension := filepath.Ext(path)
+	return fmt.Sprintf("%v.base%v", strings.TrimSuffix(path, extension), extension)
+}
@@ -0,0 +1,73 @@
+package profile
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSession(t *testing.T) {
+	dir := t.TempDir()
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	config := RegisterFlags(flags)
+	if config.Enabled() {
+		t.Fatalf("expected no profiles to be enabled by default")
+	}
+
+	err := flags.Parse([]string{
+		"-cpuprofile", filepath.Join(dir, "cpu.prof"),
+		"-memprofile", filepath.Join(dir, "mem.prof"),
+		"-blockprofile", filepath.Join(dir, "block.prof"),
+		"-trace", filepath.Join(dir, "trace.out"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !config.Enabled() {
+		t.Fatalf("expected profiles to be enabled")
+	}
+
+	for index := 0; index < 2; index += 1 {
+		session, err := config.Start(index)
+		if err != nil {
+			t.Fatal(err)
+		}
+		total := 0
+		for i := 0; i < 1000000; i += 1 {
+			total += i % 7
+		}
+		if err := session.Stop(); err != nil {
+			t.Fatal(err)
+		}
+		if err := session.Stop(); err != nil {
+			t.Errorf("expected stopping twice to do nothing, got %v", err)
+		}
+	}
+
+	for _, name := range []string{"cpu.prof", "mem.prof", "mem.base.prof", "block.prof", "trace.out", "cpu.1.prof", "mem.1.prof", "mem.1.base.prof", "block.1.prof", "trace.1.out"} {
+		info, err := os.Stat(filepath.Join(dir, name))
+		if err != nil {
+			t.Errorf("expected profile %v: %v", name, err)
+		} else if info.Size() == 0 {
+			t.Errorf("expected profile %v to be non-empty", name)
+		}
+	}
+}
+
+func TestIndexedPath(t *testing.T) {
+	testCases := map[string]string{
+		"":                 "",
+		"cpu.prof":         "cpu.3.prof",
+		"out/trace":        "out/trace.3",
+		"dir.d/profile.pb": "dir.d/profile.3.pb",
+	}
+	for path, expected := range testCases {
+		if IndexedPath(path, 3) != expected {
+			t.Errorf("got %v for %v, expected %v", IndexedPath(path, 3), path, expected)
+		}
+	}
+	if IndexedPath("cpu.prof", 0) != "cpu.prof" {
+		t.Errorf("expected index zero to leave the path unchanged")
+	}
+}
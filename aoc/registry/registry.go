@@ -0,0 +1,327 @@
+package registry
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"hmcalister/aocCommon/answer"
+	"hmcalister/aocCommon/stepview"
+	"hmcalister/aocCommon/validate"
+	"sort"
+
+	day01lib "hmcalister/aoc01/lib"
+	day01part01 "hmcalister/aoc01/part01"
+	day01part02 "hmcalister/aoc01/part02"
+	day02lib "hmcalister/aoc02/lib"
+	day02part01 "hmcalister/aoc02/part01"
+	day02part02 "hmcalister/aoc02/part02"
+	day03lib "hmcalister/aoc03/lib"
+	day03part01 "hmcalister/aoc03/part01"
+	day03part02 "hmcalister/aoc03/part02"
+	day04lib "hmcalister/aoc04/lib"
+	day04part01 "hmcalister/aoc04/part01"
+	day04part02 "hmcalister/aoc04/part02"
+	day05lib "hmcalister/aoc05/lib"
+	day05part01 "hmcalister/aoc05/part01"
+	day05part02 "hmcalister/aoc05/part02"
+	day06lib "hmcalister/aoc06/lib"
+	day06part01 "hmcalister/aoc06/part01"
+	day06part02 "hmcalister/aoc06/part02"
+	day07part01 "hmcalister/aoc07/part01"
+	day07part01lib "hmcalister/aoc07/part01/lib"
+	day07part02 "hmcalister/aoc07/part02"
+	day08lib "hmcalister/aoc08/lib"
+	day08part01 "hmcalister/aoc08/part01"
+	day08part02 "hmcalister/aoc08/part02"
+	day09lib "hmcalister/aoc09/lib"
+	day09part01 "hmcalister/aoc09/part01"
+	day09part02 "hmcalister/aoc09/part02"
+	day10lib "hmcalister/aoc10/lib"
+	day10part01 "hmcalister/aoc10/part01"
+	day10part02 "hmcalister/aoc10/part02"
+	day11lib "hmcalister/aoc11/lib"
+	day11part01 "hmcalister/aoc11/part01"
+	day11part02 "hmcalister/aoc11/part02"
+	day12lib "hmcalister/aoc12/lib"
+	day12part01 "hmcalister/aoc12/part01"
+	day12part02 "hmcalister/aoc12/part02"
+	day13lib "hmcalister/aoc13/lib"
+	day13part01 "hmcalister/aoc13/part01"
+	day13part02 "hmcalister/aoc13/part02"
+	day14lib "hmcalister/aoc14/lib"
+	day14part01 "hmcalister/aoc14/part01"
+	day14part02 "hmcalister/aoc14/part02"
+	day15lib "hmcalister/aoc15/lib"
+	day15part01 "hmcalister/aoc15/part01"
+	day15part02 "hmcalister/aoc15/part02"
+	day16lib "hmcalister/aoc16/lib"
+	day16part01 "hmcalister/aoc16/part01"
+	day16part02 "hmcalister/aoc16/part02"
+	day17lib "hmcalister/aoc17/lib"
+	day17part01 "hmcalister/aoc17/part01"
+	day17part02 "hmcalister/aoc17/part02"
+	day18lib "hmcalister/aoc18/lib"
+	day18part01 "hmcalister/aoc18/part01"
+	day18part02 "hmcalister/aoc18/part02"
+	day19part01 "hmcalister/aoc19/part01"
+	day19part01lib "hmcalister/aoc19/part01/lib"
+	day19part02 "hmcalister/aoc19/part02"
+	day20lib "hmcalister/aoc20/lib"
+	day20part01 "hmcalister/aoc20/part01"
+	day20part02 "hmcalister/aoc20/part02"
+	day21lib "hmcalister/aoc21/lib"
+	day21part01 "hmcalister/aoc21/part01"
+	day21part02 "hmcalister/aoc21/part02"
+	day23lib "hmcalister/aoc23/lib"
+	day23part01 "hmcalister/aoc23/part01"
+	day23part02 "hmcalister/aoc23/part02"
+	day24lib "hmcalister/aoc24/lib"
+	day24part01 "hmcalister/aoc24/part01"
+	day24part02 "hmcalister/aoc24/part02"
+	day25lib "hmcalister/aoc25/lib"
+	day25part01 "hmcalister/aoc25/part01"
+	day22lib "hmcalister/aox22/lib"
+	day22part01 "hmcalister/aox22/part01"
+	day22part02 "hmcalister/aox22/part02"
+)
+
+// Identifies a single solver by day and part
+type SolverKey struct {
+	Day  int
+	Part int
+}
+
+func (key SolverKey) String() string {
+	return fmt.Sprintf("day%02d/part%02d", key.Day, key.Part)
+}
+
+// Solvers with a ProcessInputContext function are registered with it directly, so they stop
+// when cancelled. All others are wrapped to only check the context before they start.
+var solvers = map[SolverKey]answer.ContextSolverFunc{
+	{Day: 1, Part: 1}:  ignoringContext(answer.Adapt(day01part01.ProcessInput)),
+	{Day: 1, Part: 2}:  ignoringContext(answer.Adapt(day01part02.ProcessInput)),
+	{Day: 2, Part: 1}:  ignoringContext(answer.Adapt(day02part01.ProcessInput)),
+	{Day: 2, Part: 2}:  ignoringContext(answer.Adapt(day02part02.ProcessInput)),
+	{Day: 3, Part: 1}:  ignoringContext(answer.Adapt(day03part01.ProcessInput)),
+	{Day: 3, Part: 2}:  ignoringContext(answer.Adapt(day03part02.ProcessInput)),
+	{Day: 4, Part: 1}:  ignoringContext(answer.Adapt(day04part01.ProcessInput)),
+	{Day: 4, Part: 2}:  ignoringContext(answer.Adapt(day04part02.ProcessInput)),
+	{Day: 5, Part: 1}:  ignoringContext(answer.Adapt(day05part01.ProcessInput)),
+	{Day: 5, Part: 2}:  ignoringContext(answer.Adapt(day05part02.ProcessInput)),
+	{Day: 6, Part: 1}:  ignoringContext(answer.Adapt(day06part01.ProcessInput)),
+	{Day: 6, Part: 2}:  ignoringContext(answer.Adapt(day06part02.ProcessInput)),
+	{Day: 7, Part: 1}:  ignoringContext(answer.Adapt(day07part01.ProcessInput)),
+	{Day: 7, Part: 2}:  ignoringContext(answer.Adapt(day07part02.ProcessInput)),
+	{Day: 8, Part: 1}:  ignoringContext(answer.Adapt(day08part01.ProcessInput)),
+	{Day: 8, Part: 2}:  ignoringContext(answer.Adapt(day08part02.ProcessInput)),
+	{Day: 9, Part: 1}:  ignoringContext(answer.Adapt(day09part01.ProcessInput)),
+	{Day: 9, Part: 2}:  ignoringContext(answer.Adapt(day09part02.ProcessInput)),
+	{Day: 10, Part: 1}: ignoringContext(answer.Adapt(day10part01.ProcessInput)),
+	{Day: 10, Part: 2}: ignoringContext(answer.Adapt(day10part02.ProcessInput)),
+	{Day: 11, Part: 1}: ignoringContext(answer.Adapt(day11part01.ProcessInput)),
+	{Day: 11, Part: 2}: ignoringContext(answer.Adapt(day11part02.ProcessInput)),
+	{Day: 12, Part: 1}: ignoringContext(answer.Adapt(day12part01.ProcessInput)),
+	{Day: 12, Part: 2}: ignoringContext(answer.Adapt(day12part02.ProcessInput)),
+	{Day: 13, Part: 1}: ignoringContext(answer.Adapt(day13part01.ProcessInput)),
+	{Day: 13, Part: 2}: ignoringContext(answer.Adapt(day13part02.ProcessInput)),
+	{Day: 14, Part: 1}: ignoringContext(answer.Adapt(day14part01.ProcessInput)),
+	{Day: 14, Part: 2}: ignoringContext(answer.Adapt(day14part02.ProcessInput)),
+	{Day: 15, Part: 1}: ignoringContext(answer.Adapt(day15part01.ProcessInput)),
+	{Day: 15, Part: 2}: ignoringContext(answer.Adapt(day15part02.ProcessInput)),
+	{Day: 16, Part: 1}: ignoringContext(answer.Adapt(day16part01.ProcessInput)),
+	{Day: 16, Part: 2}: ignoringContext(answer.Adapt(day16part02.ProcessInput)),
+	{Day: 17, Part: 1}: ignoringContext(answer.Adapt(day17part01.ProcessInput)),
+	{Day: 17, Part: 2}: ignoringContext(answer.Adapt(day17part02.ProcessInput)),
+	{Day: 18, Part: 1}: ignoringContext(answer.Adapt(day18part01.ProcessInput)),
+	{Day: 18, Part: 2}: ignoringContext(answer.Adapt(day18part02.ProcessInput)),
+	{Day: 19, Part: 1}: ignoringContext(answer.Adapt(day19part01.ProcessInput)),
+	{Day: 19, Part: 2}: ignoringContext(answer.Adapt(day19part02.ProcessInput)),
+	{Day: 20, Part: 1}: ignoringContext(answer.Adapt(day20part01.ProcessInput)),
+	{Day: 20, Part: 2}: ignoringContext(answer.Adapt(day20part02.ProcessInput)),
+	{Day: 21, Part: 1}: ignoringContext(answer.Adapt(day21part01.ProcessInput)),
+	{Day: 21, Part: 2}: answer.AdaptContext(day21part02.ProcessInputContext),
+	{Day: 22, Part: 1}: ignoringContext(answer.Adapt(day22part01.ProcessInput)),
+	{Day: 22, Part: 2}: ignoringContext(answer.Adapt(day22part02.ProcessInput)),
+	{Day: 23, Part: 1}: answer.AdaptContext(day23part01.ProcessInputContext),
+	{Day: 23, Part: 2}: answer.AdaptContext(day23part02.ProcessInputContext),
+	{Day: 24, Part: 1}: ignoringContext(answer.Adapt(day24part01.ProcessInput)),
+	{Day: 24, Part: 2}: ignoringContext(answer.Adapt(day24part02.ProcessInput)),
+	{Day: 25, Part: 1}: answer.AdaptContext(day25part01.ProcessInputContext),
+}
+
+// Optional outputs of a solver beyond the answer, which only some days and parts support
+type Options struct {
+	// If not empty, render the final state of the puzzle to a PNG file at this path
+	RenderPath string
+	// If not nil, record the state of the simulation after each step to it
+	Recorder *stepview.Recorder
+}
+
+// A solver of a day with a ProcessInputWithOptions function, built for the options of each run
+type optionsSolver struct {
+	canRender bool
+	canRecord bool
+	build     func(options Options) answer.ContextSolverFunc
+}
+
+// Solvers able to produce the optional outputs of Options, looked up by LookupWithOptions
+var optionsSolvers = map[SolverKey]optionsSolver{
+	{Day: 14, Part: 1}: {canRender: true, canRecord: true, build: func(options Options) answer.ContextSolverFunc {
+		return ignoringContext(answer.Adapt(func(fileScanner *bufio.Scanner) (int, error) {
+			return day14part01.ProcessInputWithOptions(fileScanner, day14part01.Options{RenderPath: options.RenderPath, Recorder: options.Recorder})
+		}))
+	}},
+	{Day: 14, Part: 2}: {canRender: true, canRecord: true, build: func(options Options) answer.ContextSolverFunc {
+		return ignoringContext(answer.Adapt(func(fileScanner *bufio.Scanner) (int, error) {
+			return day14part02.ProcessInputWithOptions(fileScanner, day14part02.Options{RenderPath: options.RenderPath, Recorder: options.Recorder})
+		}))
+	}},
+	{Day: 16, Part: 1}: {canRender: true, canRecord: true, build: func(options Options) answer.ContextSolverFunc {
+		return ignoringContext(answer.Adapt(func(fileScanner *bufio.Scanner) (int, error) {
+			return day16part01.ProcessInputWithOptions(fileScanner, day16part01.Options{RenderPath: options.RenderPath, Recorder: options.Recorder})
+		}))
+	}},
+	{Day: 16, Part: 2}: {canRender: true, canRecord: true, build: func(options Options) answer.ContextSolverFunc {
+		return ignoringContext(answer.Adapt(func(fileScanner *bufio.Scanner) (int, error) {
+			return day16part02.ProcessInputWithOptions(fileScanner, day16part02.Options{RenderPath: options.RenderPath, Recorder: options.Recorder})
+		}))
+	}},
+	{Day: 18, Part: 1}: {canRender: true, build: func(options Options) answer.ContextSolverFunc {
+		return ignoringContext(answer.Adapt(func(fileScanner *bufio.Scanner) (int, error) {
+			return day18part01.ProcessInputWithOptions(fileScanner, day18part01.Options{RenderPath: options.RenderPath})
+		}))
+	}},
+	{Day: 18, Part: 2}: {canRender: true, build: func(options Options) answer.ContextSolverFunc {
+		return ignoringContext(answer.Adapt(func(fileScanner *bufio.Scanner) (int, error) {
+			return day18part02.ProcessInputWithOptions(fileScanner, day18part02.Options{RenderPath: options.RenderPath})
+		}))
+	}},
+	{Day: 20, Part: 1}: {canRecord: true, build: func(options Options) answer.ContextSolverFunc {
+		return ignoringContext(answer.Adapt(func(fileScanner *bufio.Scanner) (int, error) {
+			return day20part01.ProcessInputWithOptions(fileScanner, day20part01.Options{Recorder: options.Recorder})
+		}))
+	}},
+	{Day: 20, Part: 2}: {canRecord: true, build: func(options Options) answer.ContextSolverFunc {
+		return ignoringContext(answer.Adapt(func(fileScanner *bufio.Scanner) (int, error) {
+			return day20part02.ProcessInputWithOptions(fileScanner, day20part02.Options{Recorder: options.Recorder})
+		}))
+	}},
+	{Day: 22, Part: 1}: {canRecord: true, build: func(options Options) answer.ContextSolverFunc {
+		return ignoringContext(answer.Adapt(func(fileScanner *bufio.Scanner) (int, error) {
+			return day22part01.ProcessInputWithOptions(fileScanner, day22part01.Options{Recorder: options.Recorder})
+		}))
+	}},
+	{Day: 22, Part: 2}: {canRecord: true, build: func(options Options) answer.ContextSolverFunc {
+		return ignoringContext(answer.Adapt(func(fileScanner *bufio.Scanner) (int, error) {
+			return day22part02.ProcessInputWithOptions(fileScanner, day22part02.Options{Recorder: options.Recorder})
+		}))
+	}},
+	{Day: 23, Part: 1}: {canRender: true, build: func(options Options) answer.ContextSolverFunc {
+		return answer.AdaptContext(func(ctx context.Context, fileScanner *bufio.Scanner) (int, error) {
+			return day23part01.ProcessInputWithOptions(ctx, fileScanner, day23part01.Options{RenderPath: options.RenderPath})
+		})
+	}},
+	{Day: 23, Part: 2}: {canRender: true, build: func(options Options) answer.ContextSolverFunc {
+		return answer.AdaptContext(func(ctx context.Context, fileScanner *bufio.Scanner) (int, error) {
+			return day23part02.ProcessInputWithOptions(ctx, fileScanner, day23part02.Options{RenderPath: options.RenderPath})
+		})
+	}},
+}
+
+// Validators of the puzzle input of each day, checking the format of the input before solving.
+// Requirements of only one part, such as day 8 part 1 needing nodes AAA and ZZZ, are left to that part
+var validators = map[int]validate.ValidatorFunc{
+	1:  day01lib.ValidateInput,
+	2:  day02lib.ValidateInput,
+	3:  day03lib.ValidateInput,
+	4:  day04lib.ValidateInput,
+	5:  day05lib.ValidateInput,
+	6:  day06lib.ValidateInput,
+	7:  day07part01lib.ValidateInput,
+	8:  day08lib.ValidateInput,
+	9:  day09lib.ValidateInput,
+	10: day10lib.ValidateInput,
+	11: day11lib.ValidateInput,
+	12: day12lib.ValidateInput,
+	13: day13lib.ValidateInput,
+	14: day14lib.ValidateInput,
+	15: day15lib.ValidateInput,
+	16: day16lib.ValidateInput,
+	17: day17lib.ValidateInput,
+	18: day18lib.ValidateInput,
+	19: day19part01lib.ValidateInput,
+	20: day20lib.ValidateInput,
+	21: day21lib.ValidateInput,
+	22: day22lib.ValidateInput,
+	23: day23lib.ValidateInput,
+	24: day24lib.ValidateInput,
+	25: day25lib.ValidateInput,
+}
+
+// Wrap a solver without context support, which runs to completion however the context is cancelled.
+//
+// Use answer.Run to return as soon as the context is cancelled, leaving the solver running in the background
+func ignoringContext(solver answer.SolverFunc) answer.ContextSolverFunc {
+	return func(ctx context.Context, fileScanner *bufio.Scanner) (answer.Answer, error) {
+		if err := ctx.Err(); err != nil {
+			return answer.Answer{}, err
+		}
+		return solver(fileScanner)
+	}
+}
+
+// Get the solver registered for the given day and part
+//
+// Returns an error if no such solver exists
+func Lookup(day int, part int) (answer.ContextSolverFunc, error) {
+	solver, ok := solvers[SolverKey{Day: day, Part: part}]
+	if !ok {
+		return nil, fmt.Errorf("no solver registered for day %v part %v", day, part)
+	}
+	return solver, nil
+}
+
+// Get the solver registered for the given day and part, producing the optional outputs of the options
+//
+// Returns an error if no such solver exists, or if it cannot produce an output the options ask for
+func LookupWithOptions(day int, part int, options Options) (answer.ContextSolverFunc, error) {
+	solver, err := Lookup(day, part)
+	if err != nil || options == (Options{}) {
+		return solver, err
+	}
+
+	optionsSolver, ok := optionsSolvers[SolverKey{Day: day, Part: part}]
+	if options.RenderPath != "" && !(ok && optionsSolver.canRender) {
+		return nil, fmt.Errorf("day %v part %v cannot render its state", day, part)
+	}
+	if options.Recorder != nil && !(ok && optionsSolver.canRecord) {
+		return nil, fmt.Errorf("day %v part %v cannot record its steps", day, part)
+	}
+	return optionsSolver.build(options), nil
+}
+
+// Get the keys of all registered solvers, sorted by day then part
+func Keys() []SolverKey {
+	keys := make([]SolverKey, 0, len(solvers))
+	for key := range solvers {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Day != keys[j].Day {
+			return keys[i].Day < keys[j].Day
+		}
+		return keys[i].Part < keys[j].Part
+	})
+	return keys
+}
+
+// Get the input validator registered for the given day
+//
+// Returns an error if no such validator exists
+func LookupValidator(day int) (validate.ValidatorFunc, error) {
+	validator, ok := validators[day]
+	if !ok {
+		return nil, fmt.Errorf("no validator registered for day %v", day)
+	}
+	return validator, nil
+}
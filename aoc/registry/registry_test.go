@@ -0,0 +1,155 @@
+package registry
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"hmcalister/aocCommon/answer"
+	"hmcalister/aocCommon/stepview"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func TestLookup(t *testing.T) {
+	for _, key := range Keys() {
+		if _, err := Lookup(key.Day, key.Part); err != nil {
+			t.Errorf("%v: %v", key, err)
+		}
+	}
+	if _, err := Lookup(26, 1); err == nil {
+		t.Errorf("expected error looking up unregistered solver")
+	}
+}
+
+func TestIgnoringContext(t *testing.T) {
+	solverCalled := false
+	solver := ignoringContext(func(fileScanner *bufio.Scanner) (answer.Answer, error) {
+		solverCalled = true
+		return answer.Int(1), nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := solver(ctx, bufio.NewScanner(strings.NewReader("")))
+	if !errors.Is(err, context.Canceled) || solverCalled {
+		t.Errorf("got error %v, expected %v without calling the solver", err, context.Canceled)
+	}
+
+	result, err := solver(context.Background(), bufio.NewScanner(strings.NewReader("")))
+	if err != nil || result.String() != "1" {
+		t.Errorf("got %v %v, expected 1", result, err)
+	}
+}
+
+func TestLookupValidator(t *testing.T) {
+	for day := range validators {
+		if _, err := LookupValidator(day); err != nil {
+			t.Errorf("day %v: %v", day, err)
+		}
+	}
+	if _, err := LookupValidator(26); err == nil {
+		t.Errorf("expected error looking up unregistered validator")
+	}
+}
+
+// Every day has a validator, which must accept the examples of both parts
+func TestValidatorsAcceptExamples(t *testing.T) {
+	// The validators reuse the parsers of the solutions, which log as they go
+	defer zerolog.SetGlobalLevel(zerolog.GlobalLevel())
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+
+	for day := 1; day <= 25; day += 1 {
+		validator, err := LookupValidator(day)
+		if err != nil {
+			t.Errorf("day %v: %v", day, err)
+			continue
+		}
+		// Some days have no examples, so only need a validator
+		examplePaths, err := filepath.Glob(fmt.Sprintf("../../solutions/%02d/part*/testdata/*.txt", day))
+		if err != nil {
+			t.Fatalf("day %v: %v", day, err)
+		}
+		for _, examplePath := range examplePaths {
+			exampleFile, err := os.Open(examplePath)
+			if err != nil {
+				t.Fatalf("%v: %v", examplePath, err)
+			}
+			violations := validator(bufio.NewScanner(exampleFile))
+			exampleFile.Close()
+			if len(violations) != 0 {
+				t.Errorf("%v: got violations %v, expected none", examplePath, violations)
+			}
+		}
+	}
+}
+
+// Solve the first example of a day and part with the options given, checking the answer against its expected file
+func solveExampleWithOptions(t *testing.T, day int, part int, options Options) {
+	t.Helper()
+	solver, err := LookupWithOptions(day, part, options)
+	if err != nil {
+		t.Fatalf("day %v part %v: %v", day, part, err)
+	}
+
+	examplePath := fmt.Sprintf("../../solutions/%02d/part%02d/testdata/example1", day, part)
+	expected, err := os.ReadFile(examplePath + ".expected")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exampleFile, err := os.Open(examplePath + ".txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer exampleFile.Close()
+
+	result, err := solver(context.Background(), bufio.NewScanner(exampleFile))
+	if err != nil {
+		t.Fatalf("day %v part %v: %v", day, part, err)
+	}
+	if result.String() != strings.TrimSpace(string(expected)) {
+		t.Errorf("day %v part %v: got %v, expected %v", day, part, result, strings.TrimSpace(string(expected)))
+	}
+}
+
+func TestLookupWithOptionsRender(t *testing.T) {
+	defer zerolog.SetGlobalLevel(zerolog.GlobalLevel())
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+
+	for part := 1; part <= 2; part += 1 {
+		renderPath := filepath.Join(t.TempDir(), "out.png")
+		solveExampleWithOptions(t, 16, part, Options{RenderPath: renderPath})
+		if info, err := os.Stat(renderPath); err != nil || info.Size() == 0 {
+			t.Errorf("day 16 part %v: expected a rendered image at %v (error %v)", part, renderPath, err)
+		}
+	}
+
+	if _, err := LookupWithOptions(1, 1, Options{RenderPath: "out.png"}); err == nil {
+		t.Errorf("expected error rendering a day without rendering")
+	}
+	if _, err := LookupWithOptions(26, 1, Options{}); err == nil {
+		t.Errorf("expected error looking up unregistered solver")
+	}
+}
+
+func TestLookupWithOptionsView(t *testing.T) {
+	defer zerolog.SetGlobalLevel(zerolog.GlobalLevel())
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+
+	for part := 1; part <= 2; part += 1 {
+		recorder := stepview.NewRecorder(stepview.DEFAULT_MAX_FRAMES)
+		solveExampleWithOptions(t, 16, part, Options{Recorder: recorder})
+		if len(recorder.Frames) == 0 {
+			t.Errorf("day 16 part %v: expected recorded steps", part)
+		}
+	}
+
+	// Day 18 renders but has no steps to record
+	if _, err := LookupWithOptions(18, 1, Options{Recorder: stepview.NewRecorder(1)}); err == nil {
+		t.Errorf("expected error recording a day without steps")
+	}
+}
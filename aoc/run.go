@@ -0,0 +1,301 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"hmcalister/aoc/answers"
+	"hmcalister/aoc/profile"
+	"hmcalister/aoc/registry"
+	"hmcalister/aocCommon/answer"
+	"hmcalister/aocCommon/input"
+	"hmcalister/aocCommon/stepview"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	INPUT_FILE_PATH   = "puzzleInput"
+	ANSWERS_FILE_PATH = "answers.json"
+
+	// How long to keep profiling a timed out solver in the hope it returns,
+	// before writing profiles that stop partway through the solver
+	PROFILE_GRACE_PERIOD = 10 * time.Second
+)
+
+func runCommand(args []string) {
+	var inputPaths inputPathsFlag
+
+	flags := flag.NewFlagSet("run", flag.ExitOnError)
+	dayFlag := flags.Int("day", 0, "Day of the puzzle to solve (1-25)")
+	partFlag := flags.Int("part", 1, "Part of the puzzle to solve (1 or 2)")
+	solutionsDirFlag := flags.String("solutionsDir", "solutions", "Directory containing the solution for each day")
+	flags.Var(&inputPaths, "input", "Path of an input file, or - for stdin. May be given multiple times, and any remaining arguments are also treated as inputs. Defaults to the puzzleInput file of the day")
+	answersFlag := flags.String("answers", ANSWERS_FILE_PATH, "Path of the known answers file that results are verified against")
+	recordFlag := flags.Bool("record", false, "Flag to record the result of any input without a known answer into the answers file")
+	timeoutFlag := flags.Duration("timeout", 0, "Maximum time to spend solving each input, e.g. 30s or 5m. No limit if 0")
+	validateFlag := flags.Bool("validate", false, "Flag to check each input with the validator of the day (if any) before solving, skipping inputs with violations")
+	allFlag := flags.Bool("all", false, "Flag to run every registered day and part on its puzzleInput, rather than a single day and part, and print a summary table")
+	numWorkersFlag := flags.Int("j", runtime.NumCPU(), "Number of solvers to run at once with -all")
+	renderFlag := flags.String("render", "", "Render the final state of the puzzle to this PNG file, such as out.png, for the days that support it. The images of later inputs are numbered as profiles are")
+	viewFlag := flags.Bool("view", false, "Flag to step through the states of the simulation in the terminal after solving each input, for the days that support it")
+	viewFramesFlag := flags.Int("viewFrames", stepview.DEFAULT_MAX_FRAMES, "Maximum number of steps to record for -view")
+	formatFlag := flags.String("format", FORMAT_CONSOLE, "Format of the results: console, json (one object per line) or csv. Logs are written to stderr for json and csv")
+	logConfig := registerLoggingFlags(flags)
+	profileConfig := profile.RegisterFlags(flags)
+	flags.Parse(args)
+
+	if *formatFlag == FORMAT_CONSOLE {
+		configureLogging(logConfig, os.Stdout)
+	} else {
+		configureLogging(logConfig, os.Stderr)
+	}
+
+	output, err := newResultWriter(*formatFlag, os.Stdout)
+	if err != nil {
+		log.Fatal().Msgf("error creating output: %v", err)
+	}
+
+	answerStore, err := answers.Load(*answersFlag)
+	if err != nil {
+		log.Fatal().Msgf("error loading answers file: %v", err)
+	}
+
+	options := solveOptions{
+		timeout:       *timeoutFlag,
+		validate:      *validateFlag,
+		profileConfig: profileConfig,
+	}
+
+	failed := false
+	recorded := false
+	// Verify and write each result, in the order of solving
+	report := func(result *runResult, input []byte) {
+		if result.Error != "" {
+			failed = true
+		} else {
+			resultFailed, resultRecorded := verifyResult(result, input, answerStore, *recordFlag)
+			failed = failed || resultFailed
+			recorded = recorded || resultRecorded
+		}
+		writeResult(output, *result)
+	}
+
+	if *allFlag {
+		if len(inputPaths) > 0 || flags.NArg() > 0 {
+			log.Fatal().Msg("inputs may not be given with -all, which solves the puzzleInput of each day")
+		}
+		if profileConfig.Enabled() {
+			log.Fatal().Msg("profiling is not supported with -all, as the solvers run concurrently")
+		}
+		options.profileConfig = nil
+		if *renderFlag != "" {
+			log.Fatal().Msg("rendering is not supported with -all, which would write every image to the same path")
+		}
+		if *viewFlag {
+			log.Fatal().Msg("viewing steps is not supported with -all, as the solvers run concurrently")
+		}
+
+		runStart := time.Now()
+		results, inputs := runAllSolvers(*solutionsDirFlag, options, *numWorkersFlag)
+		wallTime := time.Since(runStart)
+		for resultIndex := range results {
+			report(&results[resultIndex], inputs[resultIndex])
+		}
+		if *formatFlag == FORMAT_CONSOLE {
+			if err := writeSummaryTable(os.Stdout, results, wallTime); err != nil {
+				log.Fatal().Msgf("error writing summary: %v", err)
+			}
+		}
+	} else {
+		if *viewFlag && *formatFlag != FORMAT_CONSOLE {
+			log.Fatal().Msg("viewing steps needs the console format, as the viewer writes to stdout")
+		}
+		previousFinished := closedChannel()
+		for inputIndex, inputPath := range resolveInputPaths(inputPaths, flags.Args(), *solutionsDirFlag, *dayFlag) {
+			solverOptions := registry.Options{
+				RenderPath: profile.IndexedPath(*renderFlag, inputIndex),
+			}
+			if *viewFlag {
+				if inputPath == STDIN_INPUT_PATH {
+					log.Fatal().Msg("viewing steps is not supported with input from stdin, as the viewer reads commands from stdin")
+				}
+				solverOptions.Recorder = stepview.NewRecorder(*viewFramesFlag)
+			}
+			solver, err := registry.LookupWithOptions(*dayFlag, *partFlag, solverOptions)
+			if err != nil {
+				log.Fatal().Msgf("error finding solver: %v", err)
+			}
+			// A timed out solver would otherwise compete with the next for the CPU, skewing its time
+			waitForSolver(previousFinished)
+			var result runResult
+			var input []byte
+			result, input, previousFinished = solveInput(*dayFlag, *partFlag, inputPath, solver, options, inputIndex)
+			report(&result, input)
+
+			// A solver that failed or timed out may have recorded only some steps, or still be recording
+			if solverOptions.Recorder != nil && result.Error == "" {
+				if err := stepview.NewViewer(solverOptions.Recorder).Run(os.Stdin, os.Stdout); err != nil {
+					log.Fatal().Msgf("error viewing steps: %v", err)
+				}
+			}
+		}
+	}
+
+	if err := output.Flush(); err != nil {
+		log.Fatal().Msgf("error writing results: %v", err)
+	}
+
+	if recorded {
+		if err := answerStore.Save(*answersFlag); err != nil {
+			log.Fatal().Msgf("error saving answers file: %v", err)
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// The options of the run command applied to solving every input
+type solveOptions struct {
+	timeout       time.Duration
+	validate      bool
+	profileConfig *profile.Config
+}
+
+// Read the input and run the solver on it, returning the result (without verification), the input read,
+// and a channel closed once the solver has returned, which may be after the result if it timed out
+//
+// Profiles are numbered by the profile index, as in profile.Config.Start
+func solveInput(day int, part int, inputPath string, solver answer.ContextSolverFunc, options solveOptions, profileIndex int) (runResult, []byte, <-chan struct{}) {
+	result := runResult{
+		Day:   day,
+		Part:  part,
+		Input: inputPath,
+	}
+
+	readStart := time.Now()
+	input, err := readInput(inputPath)
+	result.ParseTimeNs = time.Since(readStart).Nanoseconds()
+	if err != nil {
+		result.Error = fmt.Sprintf("error reading input: %v", err)
+		return result, nil, closedChannel()
+	}
+
+	if options.validate {
+		if violations := validateInput(day, input); len(violations) > 0 {
+			logViolations(inputPath, violations)
+			result.Error = fmt.Sprintf("input failed validation with %v violations", len(violations))
+			return result, input, closedChannel()
+		}
+	}
+
+	// Profiles cover only the solver, with the profiles of later inputs numbered from 1
+	var profileSession *profile.Session
+	if options.profileConfig != nil {
+		profileSession, err = options.profileConfig.Start(profileIndex)
+		if err != nil {
+			log.Fatal().Msgf("error starting profiling: %v", err)
+		}
+	}
+	solveStart := time.Now()
+	solverAnswer, finished, err := runSolverOnInput(solver, input, options.timeout)
+	result.SolveTimeNs = time.Since(solveStart).Nanoseconds()
+	if profileSession != nil {
+		waitForProfiledSolver(finished)
+		if profileErr := profileSession.Stop(); profileErr != nil {
+			log.Fatal().Msgf("error writing profiles: %v", profileErr)
+		}
+	}
+	if err != nil {
+		result.Error = err.Error()
+		return result, input, finished
+	}
+	result.Result = solverAnswer.String()
+	return result, input, finished
+}
+
+// Wait for a solver to return, which may be well after it timed out
+func waitForSolver(finished <-chan struct{}) {
+	select {
+	case <-finished:
+	default:
+		log.Info().Msg("waiting for the timed out solver to return")
+		<-finished
+	}
+}
+
+// Wait a bounded time for a timed out solver to return, so its profiles cover as much of it as possible
+func waitForProfiledSolver(finished <-chan struct{}) {
+	select {
+	case <-finished:
+	default:
+		log.Info().Msgf("waiting up to %v for the timed out solver to return before writing profiles", PROFILE_GRACE_PERIOD)
+		select {
+		case <-finished:
+		case <-time.After(PROFILE_GRACE_PERIOD):
+			log.Warn().Msg("writing profiles of a solver that is still running, so they stop partway through it")
+		}
+	}
+}
+
+func closedChannel() <-chan struct{} {
+	channel := make(chan struct{})
+	close(channel)
+	return channel
+}
+
+// Check the result against the known answer of its input, recording it as the known answer if it is new and record is set
+//
+// Returns whether the verification failed, and whether the answer was recorded
+func verifyResult(result *runResult, input []byte, answerStore *answers.AnswerStore, record bool) (bool, bool) {
+	inputHash := answers.HashInput(input)
+	status, knownAnswer := answerStore.Verify(result.Day, result.Part, inputHash, result.Result)
+	result.Verification = status.String()
+	result.KnownAnswer = knownAnswer
+	switch status {
+	case answers.VERIFICATION_FAIL:
+		return true, false
+	case answers.VERIFICATION_NEW:
+		if record {
+			answerStore.Record(result.Day, result.Part, inputHash, result.Input, result.Result)
+			return false, true
+		}
+	}
+	return false, false
+}
+
+func writeResult(output resultWriter, result runResult) {
+	if err := output.Write(result); err != nil {
+		log.Fatal().Msgf("error writing result: %v", err)
+	}
+}
+
+// Run the solver on the input, giving up once the timeout has passed if it is non-zero
+//
+// A panic of the solver is returned as an error. The channel returned is closed once the solver
+// has returned, as a solver that ignores the context keeps running after timing out
+func runSolverOnInput(solver answer.ContextSolverFunc, puzzleInput []byte, timeout time.Duration) (answer.Answer, <-chan struct{}, error) {
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	fileScanner := input.NewScanner(bytes.NewReader(puzzleInput))
+	result, finished, err := answer.Run(ctx, solver, fileScanner)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return answer.Answer{}, finished, fmt.Errorf("timed out after %v", timeout)
+	}
+	if err != nil {
+		return answer.Answer{}, finished, fmt.Errorf("error processing input: %w", err)
+	}
+	return result, finished, nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"hmcalister/aoc/registry"
+	"path/filepath"
+	"sync"
+)
+
+// A registered solver to run on the puzzle input of its day, and where its result belongs
+type runAllJob struct {
+	resultIndex int
+	key         registry.SolverKey
+}
+
+// Run every registered solver on the puzzle input of its day, with at most numWorkers solvers at once
+//
+// A solver that times out without checking its context keeps its worker until it returns,
+// so the later solvers are not slowed by it
+//
+// Results and inputs are returned in the order of registry.Keys, regardless of the order solvers finish in
+func runAllSolvers(solutionsDir string, options solveOptions, numWorkers int) ([]runResult, [][]byte) {
+	keys := registry.Keys()
+	results := make([]runResult, len(keys))
+	inputs := make([][]byte, len(keys))
+
+	jobs := make(chan runAllJob)
+	var workers sync.WaitGroup
+	for i := 0; i < max(numWorkers, 1); i += 1 {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for job := range jobs {
+				inputPath := filepath.Join(solutionsDir, fmt.Sprintf("%02d", job.key.Day), INPUT_FILE_PATH)
+				solver, err := registry.Lookup(job.key.Day, job.key.Part)
+				if err != nil {
+					results[job.resultIndex] = runResult{Day: job.key.Day, Part: job.key.Part, Input: inputPath, Error: err.Error()}
+					continue
+				}
+				var finished <-chan struct{}
+				results[job.resultIndex], inputs[job.resultIndex], finished = solveInput(job.key.Day, job.key.Part, inputPath, solver, options, 0)
+				waitForSolver(finished)
+			}
+		}()
+	}
+
+	for resultIndex, key := range keys {
+		jobs <- runAllJob{resultIndex: resultIndex, key: key}
+	}
+	close(jobs)
+	workers.Wait()
+
+	return results, inputs
+}
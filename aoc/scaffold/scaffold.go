@@ -0,0 +1,115 @@
+// Creation of the directory of a new day from solutions/TEMPLATE, with the module path of
+// the template replaced by that of the new day.
+package scaffold
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	MODULE_PATH_FORMAT = "hmcalister/aoc%02d"
+	TEMPLATE_DIR_NAME  = "TEMPLATE"
+)
+
+// The module path of the solution of a day, e.g. hmcalister/aoc05
+func ModulePath(day int) string {
+	return fmt.Sprintf(MODULE_PATH_FORMAT, day)
+}
+
+// Read the module path declared in the go.mod of a directory
+func ReadModulePath(dir string) (string, error) {
+	goModFile, err := os.Open(filepath.Join(dir, "go.mod"))
+	if err != nil {
+		return "", err
+	}
+	defer goModFile.Close()
+
+	fileScanner := bufio.NewScanner(goModFile)
+	for fileScanner.Scan() {
+		line := strings.TrimSpace(fileScanner.Text())
+		if modulePath, found := strings.CutPrefix(line, "module "); found {
+			return strings.TrimSpace(modulePath), nil
+		}
+	}
+	if err := fileScanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("no module declaration in %v", filepath.Join(dir, "go.mod"))
+}
+
+// Create the directory of a day in solutionsDir by copying the TEMPLATE directory,
+// returning the path of the new directory
+//
+// The module path of the template is replaced by that of the day in go.mod and every Go file.
+// An existing directory is never overwritten, and no other day may already use the module path
+func Create(solutionsDir string, day int) (string, error) {
+	if day < 1 || day > 25 {
+		return "", fmt.Errorf("day %v is not between 1 and 25", day)
+	}
+	modulePath := ModulePath(day)
+
+	dayDir := filepath.Join(solutionsDir, fmt.Sprintf("%02d", day))
+	if _, err := os.Stat(dayDir); err == nil {
+		return "", fmt.Errorf("directory %v already exists", dayDir)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+
+	existingDirs, err := filepath.Glob(filepath.Join(solutionsDir, "*", "go.mod"))
+	if err != nil {
+		return "", err
+	}
+	for _, existingGoModPath := range existingDirs {
+		existingDir := filepath.Dir(existingGoModPath)
+		if filepath.Base(existingDir) == TEMPLATE_DIR_NAME {
+			continue
+		}
+		existingModulePath, err := ReadModulePath(existingDir)
+		if err != nil {
+			return "", err
+		}
+		if existingModulePath == modulePath {
+			return "", fmt.Errorf("module path %q is already used by %v", modulePath, existingDir)
+		}
+	}
+
+	templateDir := filepath.Join(solutionsDir, TEMPLATE_DIR_NAME)
+	templateModulePath, err := ReadModulePath(templateDir)
+	if err != nil {
+		return "", fmt.Errorf("error reading template: %w", err)
+	}
+
+	err = filepath.WalkDir(templateDir, func(templatePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		relativePath, err := filepath.Rel(templateDir, templatePath)
+		if err != nil {
+			return err
+		}
+		targetPath := filepath.Join(dayDir, relativePath)
+		if entry.IsDir() {
+			return os.MkdirAll(targetPath, 0755)
+		}
+
+		data, err := os.ReadFile(templatePath)
+		if err != nil {
+			return err
+		}
+		if filepath.Ext(templatePath) == ".go" || filepath.Base(templatePath) == "go.mod" {
+			data = bytes.ReplaceAll(data, []byte(templateModulePath), []byte(modulePath))
+		}
+		return os.WriteFile(targetPath, data, 0644)
+	})
+	if err != nil {
+		return "", err
+	}
+	return dayDir, nil
+}
@@ -0,0 +1,59 @@
+package scaffold
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeFile(t *testing.T, path string, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	solutionsDir := t.TempDir()
+	writeFile(t, filepath.Join(solutionsDir, "TEMPLATE", "go.mod"), "module hmcalister/aocTemplate\n\nreplace hmcalister/aocCommon => ../../common\n")
+	writeFile(t, filepath.Join(solutionsDir, "TEMPLATE", "part01", "part01.go"), "package part01\n\nimport \"hmcalister/aocTemplate/lib\"\n")
+	writeFile(t, filepath.Join(solutionsDir, "TEMPLATE", "part01", "testdata", "example1.txt"), "")
+	writeFile(t, filepath.Join(solutionsDir, "TEMPLATE", "lib", "lib.go"), "package lib\n")
+	writeFile(t, filepath.Join(solutionsDir, "04", "go.mod"), "module hmcalister/aoc05\n")
+
+	dayDir, err := Create(solutionsDir, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dayDir != filepath.Join(solutionsDir, "03") {
+		t.Errorf("got directory %v", dayDir)
+	}
+
+	modulePath, err := ReadModulePath(dayDir)
+	if err != nil || modulePath != "hmcalister/aoc03" {
+		t.Errorf("got module path %v (error %v), expected hmcalister/aoc03", modulePath, err)
+	}
+	partFile, err := os.ReadFile(filepath.Join(dayDir, "part01", "part01.go"))
+	if err != nil || !strings.Contains(string(partFile), `"hmcalister/aoc03/lib"`) {
+		t.Errorf("expected part01.go to import the new module, got %q (error %v)", partFile, err)
+	}
+	for _, path := range []string{"lib/lib.go", "part01/testdata/example1.txt"} {
+		if _, err := os.Stat(filepath.Join(dayDir, path)); err != nil {
+			t.Errorf("expected %v to be created: %v", path, err)
+		}
+	}
+
+	if _, err := Create(solutionsDir, 3); err == nil {
+		t.Errorf("expected error creating a day that already exists")
+	}
+	if _, err := Create(solutionsDir, 5); err == nil {
+		t.Errorf("expected error creating a day whose module path is already used")
+	}
+	if _, err := Create(solutionsDir, 26); err == nil {
+		t.Errorf("expected error creating a day past 25")
+	}
+}
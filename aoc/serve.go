@@ -0,0 +1,41 @@
+package main
+
+import (
+	"flag"
+	"hmcalister/aoc/registry"
+	"hmcalister/aoc/server"
+	"net/http"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Serve the registered solvers over HTTP until the process is stopped
+func serveCommand(args []string) {
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	addrFlag := flags.String("addr", "localhost:8023", "Address to listen on")
+	maxInputBytesFlag := flags.Int64("maxInputBytes", server.DEFAULT_MAX_INPUT_BYTES, "Maximum size of a puzzle input in bytes")
+	timeoutFlag := flags.Duration("timeout", server.DEFAULT_TIMEOUT, "Maximum time for each request to wait for a solver and be solved, e.g. 30s or 5m. No limit if 0")
+	numWorkersFlag := flags.Int("j", runtime.NumCPU(), "Number of inputs to solve at once")
+	logConfig := registerLoggingFlags(flags)
+	flags.Parse(args)
+
+	configureLogging(logConfig, os.Stdout)
+
+	httpServer := &http.Server{
+		Addr: *addrFlag,
+		Handler: server.New(registry.Lookup, server.Config{
+			MaxInputBytes: *maxInputBytesFlag,
+			Timeout:       *timeoutFlag,
+			MaxConcurrent: *numWorkersFlag,
+		}),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	log.Info().Msgf("serving solvers on http://%v%v{day}/{part}", *addrFlag, server.SOLVE_PATH_PREFIX)
+	if err := httpServer.ListenAndServe(); err != nil {
+		log.Fatal().Msgf("error serving: %v", err)
+	}
+}
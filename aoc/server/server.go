@@ -0,0 +1,189 @@
+// An HTTP API solving puzzle inputs with the registered solvers, so other tools can
+// call them without running the aoc command.
+//
+//	POST /solve/{day}/{part}
+//
+// The request body is the puzzle input, and the response is a SolveResponse as JSON.
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hmcalister/aocCommon/answer"
+	"hmcalister/aocCommon/input"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	SOLVE_PATH_PREFIX = "/solve/"
+
+	// Puzzle inputs are tens of kilobytes, so this leaves plenty of room
+	DEFAULT_MAX_INPUT_BYTES = 1 << 20
+	DEFAULT_TIMEOUT         = 30 * time.Second
+)
+
+// Find the solver of a day and part, such as registry.Lookup
+type LookupFunc func(day int, part int) (answer.ContextSolverFunc, error)
+
+type Config struct {
+	// Requests with a larger body are rejected without being solved. DEFAULT_MAX_INPUT_BYTES if 0
+	MaxInputBytes int64
+	// Maximum time for a request to wait for a free solver and be solved. No limit if 0
+	Timeout time.Duration
+	// Maximum number of inputs solved at once, counting solvers still running after timing out.
+	// Further requests wait for a solver to finish
+	MaxConcurrent int
+}
+
+// The outcome of a solve request
+type SolveResponse struct {
+	Day    int    `json:"day"`
+	Part   int    `json:"part"`
+	Result string `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+	// Time taken to read the request body
+	ReadTimeNs int64 `json:"readTimeNs"`
+	// Time spent waiting for a solver to be free
+	QueueTimeNs int64 `json:"queueTimeNs"`
+	SolveTimeNs int64 `json:"solveTimeNs"`
+}
+
+type Server struct {
+	lookup LookupFunc
+	config Config
+	// Holds a value for each input being solved, limiting the number solved at once
+	solverSlots chan struct{}
+}
+
+func New(lookup LookupFunc, config Config) *Server {
+	if config.MaxInputBytes <= 0 {
+		config.MaxInputBytes = DEFAULT_MAX_INPUT_BYTES
+	}
+	return &Server{
+		lookup:      lookup,
+		config:      config,
+		solverSlots: make(chan struct{}, max(config.MaxConcurrent, 1)),
+	}
+}
+
+func (server *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	day, part, ok := parseSolvePath(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	response := SolveResponse{
+		Day:  day,
+		Part: part,
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		server.writeError(w, http.StatusMethodNotAllowed, response, fmt.Errorf("method %v not allowed, use POST", r.Method))
+		return
+	}
+
+	solver, err := server.lookup(day, part)
+	if err != nil {
+		server.writeError(w, http.StatusNotFound, response, err)
+		return
+	}
+
+	readStart := time.Now()
+	puzzleInput, err := io.ReadAll(http.MaxBytesReader(w, r.Body, server.config.MaxInputBytes))
+	response.ReadTimeNs = time.Since(readStart).Nanoseconds()
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			server.writeError(w, http.StatusRequestEntityTooLarge, response, fmt.Errorf("input is larger than the limit of %v bytes", maxBytesErr.Limit))
+			return
+		}
+		server.writeError(w, http.StatusBadRequest, response, fmt.Errorf("error reading input: %w", err))
+		return
+	}
+
+	ctx := r.Context()
+	if server.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, server.config.Timeout)
+		defer cancel()
+	}
+
+	queueStart := time.Now()
+	select {
+	case server.solverSlots <- struct{}{}:
+	case <-ctx.Done():
+		response.QueueTimeNs = time.Since(queueStart).Nanoseconds()
+		server.writeError(w, http.StatusServiceUnavailable, response, fmt.Errorf("no solver was free before the request ended: %w", ctx.Err()))
+		return
+	}
+	response.QueueTimeNs = time.Since(queueStart).Nanoseconds()
+
+	solveStart := time.Now()
+	solverAnswer, finished, err := answer.Run(ctx, solver, input.NewScanner(bytes.NewReader(puzzleInput)))
+	response.SolveTimeNs = time.Since(solveStart).Nanoseconds()
+	// A solver that ignores the context keeps running after timing out, so keep its slot until it returns
+	go func() {
+		<-finished
+		<-server.solverSlots
+	}()
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		server.writeError(w, http.StatusGatewayTimeout, response, fmt.Errorf("timed out after %v", server.config.Timeout))
+	case errors.Is(err, answer.ErrSolverPanicked):
+		server.writeError(w, http.StatusInternalServerError, response, err)
+	case err != nil:
+		server.writeError(w, http.StatusUnprocessableEntity, response, fmt.Errorf("error processing input: %w", err))
+	default:
+		response.Result = solverAnswer.String()
+		server.writeResponse(w, http.StatusOK, response)
+	}
+}
+
+// Get the day and part of a path of the form /solve/{day}/{part}
+func parseSolvePath(path string) (int, int, bool) {
+	fields := strings.Split(strings.TrimPrefix(path, SOLVE_PATH_PREFIX), "/")
+	if !strings.HasPrefix(path, SOLVE_PATH_PREFIX) || len(fields) != 2 {
+		return 0, 0, false
+	}
+	day, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	part, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return 0, 0, false
+	}
+	return day, part, true
+}
+
+func (server *Server) writeError(w http.ResponseWriter, status int, response SolveResponse, err error) {
+	response.Error = err.Error()
+	server.writeResponse(w, status, response)
+}
+
+func (server *Server) writeResponse(w http.ResponseWriter, status int, response SolveResponse) {
+	log.Info().
+		Int("Day", response.Day).
+		Int("Part", response.Part).
+		Int("Status", status).
+		Str("Result", response.Result).
+		Str("Error", response.Error).
+		Int64("SolveTimeNs", response.SolveTimeNs).
+		Msg("solve request")
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Error().Msgf("error writing response: %v", err)
+	}
+}
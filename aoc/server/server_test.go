@@ -0,0 +1,192 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hmcalister/aocCommon/answer"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Solvers by day, each run as part 1
+var testSolvers = map[int]answer.ContextSolverFunc{
+	// Count the lines of the input
+	1: func(ctx context.Context, fileScanner *bufio.Scanner) (answer.Answer, error) {
+		lineCount := 0
+		for fileScanner.Scan() {
+			lineCount += 1
+		}
+		return answer.Int(lineCount), nil
+	},
+	2: func(ctx context.Context, fileScanner *bufio.Scanner) (answer.Answer, error) {
+		return answer.Answer{}, errors.New("bad input")
+	},
+	3: func(ctx context.Context, fileScanner *bufio.Scanner) (answer.Answer, error) {
+		panic("index out of range")
+	},
+	// Wait until cancelled
+	4: func(ctx context.Context, fileScanner *bufio.Scanner) (answer.Answer, error) {
+		<-ctx.Done()
+		return answer.Answer{}, ctx.Err()
+	},
+}
+
+func testLookup(day int, part int) (answer.ContextSolverFunc, error) {
+	solver, ok := testSolvers[day]
+	if !ok || part != 1 {
+		return nil, fmt.Errorf("no solver registered for day %v part %v", day, part)
+	}
+	return solver, nil
+}
+
+func newTestServer(config Config) *httptest.Server {
+	return httptest.NewServer(New(testLookup, config))
+}
+
+func post(t *testing.T, url string, body string) (int, SolveResponse) {
+	t.Helper()
+	httpResponse, err := http.Post(url, "text/plain", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("error posting to %v: %v", url, err)
+	}
+	defer httpResponse.Body.Close()
+
+	var response SolveResponse
+	if err := json.NewDecoder(httpResponse.Body).Decode(&response); err != nil {
+		t.Fatalf("error decoding response: %v", err)
+	}
+	return httpResponse.StatusCode, response
+}
+
+func TestSolveResponses(t *testing.T) {
+	testServer := newTestServer(Config{
+		MaxInputBytes: 16,
+		Timeout:       50 * time.Millisecond,
+		MaxConcurrent: 2,
+	})
+	defer testServer.Close()
+
+	tests := []struct {
+		name          string
+		path          string
+		body          string
+		status        int
+		result        string
+		errorContains string
+	}{
+		{"Solved", "/solve/1/1", "a\nb\nc\n", http.StatusOK, "3", ""},
+		{"UnknownSolver", "/solve/9/1", "", http.StatusNotFound, "", "no solver registered"},
+		{"InputTooLarge", "/solve/1/1", strings.Repeat("a\n", 9), http.StatusRequestEntityTooLarge, "", "larger than the limit of 16 bytes"},
+		{"SolverError", "/solve/2/1", "", http.StatusUnprocessableEntity, "", "error processing input: bad input"},
+		{"SolverPanic", "/solve/3/1", "", http.StatusInternalServerError, "", "index out of range"},
+		{"TimedOut", "/solve/4/1", "", http.StatusGatewayTimeout, "", "timed out after 50ms"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status, response := post(t, testServer.URL+test.path, test.body)
+			if status != test.status {
+				t.Errorf("got status %v, expected %v (response %+v)", status, test.status, response)
+			}
+			if response.Result != test.result {
+				t.Errorf("got result %q, expected %q", response.Result, test.result)
+			}
+			if !strings.Contains(response.Error, test.errorContains) {
+				t.Errorf("got error %q, expected it to contain %q", response.Error, test.errorContains)
+			}
+		})
+	}
+}
+
+func TestSolveRejectsOtherMethods(t *testing.T) {
+	testServer := newTestServer(Config{})
+	defer testServer.Close()
+
+	httpResponse, err := http.Get(testServer.URL + "/solve/1/1")
+	if err != nil {
+		t.Fatalf("error getting: %v", err)
+	}
+	httpResponse.Body.Close()
+	if httpResponse.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("got status %v, expected %v", httpResponse.StatusCode, http.StatusMethodNotAllowed)
+	}
+	if allow := httpResponse.Header.Get("Allow"); allow != http.MethodPost {
+		t.Errorf("got Allow header %q, expected %q", allow, http.MethodPost)
+	}
+}
+
+func TestParseSolvePath(t *testing.T) {
+	tests := []struct {
+		path string
+		day  int
+		part int
+		ok   bool
+	}{
+		{"/solve/16/2", 16, 2, true},
+		{"/solve/16", 0, 0, false},
+		{"/solve/16/2/", 0, 0, false},
+		{"/solve/a/2", 0, 0, false},
+		{"/run/16/2", 0, 0, false},
+	}
+	for _, test := range tests {
+		day, part, ok := parseSolvePath(test.path)
+		if day != test.day || part != test.part || ok != test.ok {
+			t.Errorf("parseSolvePath(%q) = %v, %v, %v, expected %v, %v, %v", test.path, day, part, ok, test.day, test.part, test.ok)
+		}
+	}
+}
+
+// A request waiting for a solver past its timeout is turned away, including while a solver
+// ignoring its context keeps running after its own request timed out
+func TestSolveWhenBusy(t *testing.T) {
+	release := make(chan struct{})
+	lookup := func(day int, part int) (answer.ContextSolverFunc, error) {
+		if day == 5 {
+			return func(ctx context.Context, fileScanner *bufio.Scanner) (answer.Answer, error) {
+				<-release
+				return answer.Int(5), nil
+			}, nil
+		}
+		return testLookup(day, part)
+	}
+	testServer := httptest.NewServer(New(lookup, Config{
+		MaxInputBytes: DEFAULT_MAX_INPUT_BYTES,
+		Timeout:       100 * time.Millisecond,
+		MaxConcurrent: 1,
+	}))
+	defer testServer.Close()
+
+	blockingDone := make(chan int)
+	go func() {
+		status, _ := post(t, testServer.URL+"/solve/5/1", "")
+		blockingDone <- status
+	}()
+	// Let the blocking request take the only solver
+	time.Sleep(20 * time.Millisecond)
+
+	status, response := post(t, testServer.URL+"/solve/1/1", "a\n")
+	if status != http.StatusServiceUnavailable {
+		t.Errorf("got status %v, expected %v (response %+v)", status, http.StatusServiceUnavailable, response)
+	}
+	if status := <-blockingDone; status != http.StatusGatewayTimeout {
+		t.Errorf("got status %v for the blocking request, expected %v", status, http.StatusGatewayTimeout)
+	}
+
+	status, response = post(t, testServer.URL+"/solve/1/1", "a\n")
+	if status != http.StatusServiceUnavailable {
+		t.Errorf("got status %v while the timed out solver runs, expected %v (response %+v)", status, http.StatusServiceUnavailable, response)
+	}
+
+	close(release)
+	// The slot is released just after the solver returns
+	time.Sleep(20 * time.Millisecond)
+	status, response = post(t, testServer.URL+"/solve/1/1", "a\n")
+	if status != http.StatusOK || response.Result != "1" {
+		t.Errorf("got status %v and result %q once free, expected %v and %q", status, response.Result, http.StatusOK, "1")
+	}
+}
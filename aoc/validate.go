@@ -0,0 +1,57 @@
+package main
+
+import (
+	"flag"
+	"hmcalister/aoc/registry"
+	"hmcalister/aocCommon/parse"
+	"os"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Check the structure of the inputs of a day, reporting every violation found
+func validateCommand(args []string) {
+	var inputPaths inputPathsFlag
+
+	flags := flag.NewFlagSet("validate", flag.ExitOnError)
+	dayFlag := flags.Int("day", 0, "Day of the puzzle input to validate (1-25)")
+	solutionsDirFlag := flags.String("solutionsDir", "solutions", "Directory containing the solution for each day")
+	flags.Var(&inputPaths, "input", "Path of an input file, or - for stdin. May be given multiple times, and any remaining arguments are also treated as inputs. Defaults to the puzzleInput file of the day")
+	logConfig := registerLoggingFlags(flags)
+	flags.Parse(args)
+
+	configureLogging(logConfig, os.Stdout)
+
+	if _, err := registry.LookupValidator(*dayFlag); err != nil {
+		log.Fatal().Msgf("error finding validator: %v", err)
+	}
+
+	failed := false
+	for _, inputPath := range resolveInputPaths(inputPaths, flags.Args(), *solutionsDirFlag, *dayFlag) {
+		input, err := readInput(inputPath)
+		if err != nil {
+			failed = true
+			log.Error().Str("Input", inputPath).Msgf("error reading input: %v", err)
+			continue
+		}
+
+		violations := validateInput(*dayFlag, input)
+		if len(violations) > 0 {
+			failed = true
+			logViolations(inputPath, violations)
+			continue
+		}
+		log.Info().Int("Day", *dayFlag).Str("Input", inputPath).Msg("input is valid")
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func logViolations(inputPath string, violations []*parse.ParseError) {
+	for _, violation := range violations {
+		log.Error().Str("Input", inputPath).Msg(violation.Error())
+	}
+	log.Error().Str("Input", inputPath).Int("NumViolations", len(violations)).Msg("input failed validation")
+}
@@ -0,0 +1,123 @@
+// The answer to a puzzle, which may be an int64, an arbitrarily large *big.Int or a string.
+package answer
+
+import (
+	"bufio"
+	"context"
+	"math/big"
+	"strconv"
+)
+
+type Answer struct {
+	kind        AnswerKindEnum
+	intValue    int64
+	bigIntValue *big.Int
+	stringValue string
+}
+
+// The signature of a ProcessInput function that returns an Answer
+type SolverFunc func(fileScanner *bufio.Scanner) (Answer, error)
+
+// The signature of a ProcessInputContext function that returns an Answer,
+// which should stop early with the context error once the context is cancelled
+type ContextSolverFunc func(ctx context.Context, fileScanner *bufio.Scanner) (Answer, error)
+
+// The results a ProcessInput function may return
+type Result interface {
+	int | Answer
+}
+
+// Adapt a ProcessInput function returning either an int or an Answer to a SolverFunc,
+// so existing solutions returning an int can be used alongside those returning an Answer
+func Adapt[R Result](solver func(fileScanner *bufio.Scanner) (R, error)) SolverFunc {
+	return func(fileScanner *bufio.Scanner) (Answer, error) {
+		result, err := solver(fileScanner)
+		switch result := any(result).(type) {
+		case int:
+			return Int(result), err
+		case Answer:
+			return result, err
+		}
+		panic("unreachable")
+	}
+}
+
+// Adapt a ProcessInputContext function returning either an int or an Answer to a ContextSolverFunc
+func AdaptContext[R Result](solver func(ctx context.Context, fileScanner *bufio.Scanner) (R, error)) ContextSolverFunc {
+	return func(ctx context.Context, fileScanner *bufio.Scanner) (Answer, error) {
+		return Adapt(func(fileScanner *bufio.Scanner) (R, error) {
+			return solver(ctx, fileScanner)
+		})(fileScanner)
+	}
+}
+
+func Int(value int) Answer {
+	return Int64(int64(value))
+}
+
+func Int64(value int64) Answer {
+	return Answer{
+		kind:     ANSWER_KIND_INT,
+		intValue: value,
+	}
+}
+
+// Create an answer from a big.Int. The value is copied, so later changes to value are not reflected.
+func BigInt(value *big.Int) Answer {
+	return Answer{
+		kind:        ANSWER_KIND_BIG_INT,
+		bigIntValue: new(big.Int).Set(value),
+	}
+}
+
+func String(value string) Answer {
+	return Answer{
+		kind:        ANSWER_KIND_STRING,
+		stringValue: value,
+	}
+}
+
+func (answer Answer) Kind() AnswerKindEnum {
+	return answer.kind
+}
+
+// Get the answer as an int64, if it is an integer that fits in one
+func (answer Answer) Int64() (int64, bool) {
+	switch answer.kind {
+	case ANSWER_KIND_INT:
+		return answer.intValue, true
+	case ANSWER_KIND_BIG_INT:
+		return answer.bigIntValue.Int64(), answer.bigIntValue.IsInt64()
+	default:
+		return 0, false
+	}
+}
+
+// Get the answer as a big.Int, if it is an integer
+func (answer Answer) BigInt() (*big.Int, bool) {
+	switch answer.kind {
+	case ANSWER_KIND_INT:
+		return big.NewInt(answer.intValue), true
+	case ANSWER_KIND_BIG_INT:
+		return new(big.Int).Set(answer.bigIntValue), true
+	default:
+		return nil, false
+	}
+}
+
+func (answer Answer) String() string {
+	switch answer.kind {
+	case ANSWER_KIND_INT:
+		return strconv.FormatInt(answer.intValue, 10)
+	case ANSWER_KIND_BIG_INT:
+		return answer.bigIntValue.String()
+	default:
+		return answer.stringValue
+	}
+}
+
+// Check if two answers are the same, regardless of how they are stored
+// (e.g. an int64 and a big.Int of the same value are equal)
+func (answer Answer) Equal(other Answer) bool {
+	return answer.String() == other.String()
+}
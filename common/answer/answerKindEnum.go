@@ -0,0 +1,10 @@
+package answer
+
+//go:generate stringer -type=AnswerKindEnum
+type AnswerKindEnum int
+
+const (
+	ANSWER_KIND_INT     AnswerKindEnum = iota
+	ANSWER_KIND_BIG_INT AnswerKindEnum = iota
+	ANSWER_KIND_STRING  AnswerKindEnum = iota
+)
@@ -0,0 +1,107 @@
+package answer
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAnswerString(t *testing.T) {
+	overflowingValue := new(big.Int).Mul(big.NewInt(math.MaxInt64), big.NewInt(10))
+
+	testCases := []struct {
+		answer   Answer
+		expected string
+	}{
+		{Int(142), "142"},
+		{Int64(math.MinInt64), "-9223372036854775808"},
+		{BigInt(overflowingValue), "92233720368547758070"},
+		{String("AB,CD"), "AB,CD"},
+	}
+	for _, testCase := range testCases {
+		if result := testCase.answer.String(); result != testCase.expected {
+			t.Errorf("%v answer: got %v, expected %v", testCase.answer.Kind(), result, testCase.expected)
+		}
+	}
+}
+
+func TestAnswerConversions(t *testing.T) {
+	if value, ok := BigInt(big.NewInt(42)).Int64(); !ok || value != 42 {
+		t.Errorf("expected small big.Int to convert to int64, got %v %v", value, ok)
+	}
+	overflowingValue := new(big.Int).Lsh(big.NewInt(1), 70)
+	if _, ok := BigInt(overflowingValue).Int64(); ok {
+		t.Errorf("expected overflowing big.Int not to convert to int64")
+	}
+	if _, ok := String("abc").BigInt(); ok {
+		t.Errorf("expected string answer not to convert to big.Int")
+	}
+	if !Int(42).Equal(BigInt(big.NewInt(42))) {
+		t.Errorf("expected int and big.Int answers of the same value to be equal")
+	}
+}
+
+func TestAdapt(t *testing.T) {
+	intSolver := func(fileScanner *bufio.Scanner) (int, error) {
+		fileScanner.Scan()
+		return len(fileScanner.Text()), nil
+	}
+	answerSolver := func(fileScanner *bufio.Scanner) (Answer, error) {
+		fileScanner.Scan()
+		return String(fileScanner.Text()), nil
+	}
+
+	result, err := Adapt(intSolver)(bufio.NewScanner(strings.NewReader("abc")))
+	if err != nil || result.Kind() != ANSWER_KIND_INT || result.String() != "3" {
+		t.Errorf("adapted int solver: got %v %v", result, err)
+	}
+	result, err = Adapt(answerSolver)(bufio.NewScanner(strings.NewReader("abc")))
+	if err != nil || result.Kind() != ANSWER_KIND_STRING || result.String() != "abc" {
+		t.Errorf("adapted answer solver: got %v %v", result, err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	emptyScanner := func() *bufio.Scanner {
+		return bufio.NewScanner(strings.NewReader(""))
+	}
+
+	// A solver ignoring the context is given up on, but only reported finished once it returns
+	release := make(chan struct{})
+	blockingSolver := func(ctx context.Context, fileScanner *bufio.Scanner) (Answer, error) {
+		<-release
+		return Int(1), nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, finished, err := Run(ctx, blockingSolver, emptyScanner())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, expected %v", err, context.DeadlineExceeded)
+	}
+	select {
+	case <-finished:
+		t.Errorf("expected the blocking solver not to be finished before it is released")
+	default:
+	}
+	close(release)
+	<-finished
+
+	result, finished, err := Run(context.Background(), blockingSolver, emptyScanner())
+	<-finished
+	if err != nil || result.String() != "1" {
+		t.Errorf("got %v %v, expected 1", result, err)
+	}
+
+	panickingSolver := func(ctx context.Context, fileScanner *bufio.Scanner) (Answer, error) {
+		panic("index out of range")
+	}
+	_, _, err = Run(context.Background(), panickingSolver, emptyScanner())
+	if !errors.Is(err, ErrSolverPanicked) || !strings.Contains(err.Error(), "index out of range") {
+		t.Errorf("got error %v, expected the panic wrapping %v", err, ErrSolverPanicked)
+	}
+}
@@ -0,0 +1,26 @@
+// Code generated by "stringer -type=AnswerKindEnum"; DO NOT EDIT.
+
+package answer
+
+import "strconv"
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[ANSWER_KIND_INT-0]
+	_ = x[ANSWER_KIND_BIG_INT-1]
+	_ = x[ANSWER_KIND_STRING-2]
+}
+
+const _AnswerKindEnum_name = "ANSWER_KIND_INTANSWER_KIND_BIG_INTANSWER_KIND_STRING"
+
+var _AnswerKindEnum_index = [...]uint8{0, 15, 34, 52}
+
+func (i AnswerKindEnum) String() string {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_AnswerKindEnum_index)-1 {
+		return "AnswerKindEnum(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _AnswerKindEnum_name[_AnswerKindEnum_index[idx]:_AnswerKindEnum_index[idx+1]]
+}
@@ -0,0 +1,48 @@
+package answer
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+)
+
+var ErrSolverPanicked = errors.New("solver panicked")
+
+type runResult struct {
+	answer Answer
+	err    error
+}
+
+// Run a solver in its own goroutine, returning its answer, or the context error if the context is done first.
+// A panic of the solver is returned as an error wrapping ErrSolverPanicked.
+//
+// A solver that does not check the context keeps running after the context is done. The returned
+// channel is closed once the solver has actually returned, so callers limiting how many solvers
+// run at once can hold their slot until then rather than until Run returns.
+func Run(ctx context.Context, solver ContextSolverFunc, fileScanner *bufio.Scanner) (Answer, <-chan struct{}, error) {
+	finished := make(chan struct{})
+	if err := ctx.Err(); err != nil {
+		close(finished)
+		return Answer{}, finished, err
+	}
+
+	resultChannel := make(chan runResult, 1)
+	go func() {
+		defer close(finished)
+		defer func() {
+			if r := recover(); r != nil {
+				resultChannel <- runResult{err: fmt.Errorf("%w: %v", ErrSolverPanicked, r)}
+			}
+		}()
+		result, err := solver(ctx, fileScanner)
+		resultChannel <- runResult{result, err}
+	}()
+
+	select {
+	case result := <-resultChannel:
+		return result.answer, finished, result.err
+	case <-ctx.Done():
+		return Answer{}, finished, ctx.Err()
+	}
+}
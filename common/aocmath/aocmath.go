@@ -0,0 +1,128 @@
+// Number theory for puzzles built from cycles, such as finding when several cycles line up.
+//
+// The int functions return ErrOverflow rather than silently wrapping around, and
+// each has a big.Int counterpart for when the answer does not fit in an int.
+package aocmath
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+var (
+	ErrOverflow       = errors.New("result overflows int")
+	ErrNoSolution     = errors.New("no solution")
+	ErrNotInvertible  = errors.New("not invertible")
+	ErrInvalidModulus = errors.New("modulus must be positive")
+)
+
+// The magnitude of a value, which unlike an int holds the magnitude of math.MinInt
+func magnitude(value int) uint64 {
+	if value < 0 {
+		return uint64(-value)
+	}
+	return uint64(value)
+}
+
+// Convert a magnitude back to an int, failing if it is too large
+func fromMagnitude(value uint64) (int, bool) {
+	if value > math.MaxInt {
+		return 0, false
+	}
+	return int(value), true
+}
+
+func gcdMagnitude(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// Find the Greatest Common Divisor (GCD) of two values, which is never negative.
+//
+// The GCD of 0 and 0 is 0. Only fails for math.MinInt with 0 or itself.
+func GCD(a, b int) (int, error) {
+	result, ok := fromMagnitude(gcdMagnitude(magnitude(a), magnitude(b)))
+	if !ok {
+		return 0, fmt.Errorf("%w: gcd of %v and %v", ErrOverflow, a, b)
+	}
+	return result, nil
+}
+
+// Find the GCD of all values, or 0 if there are none
+func GCDOfSlice(values []int) (int, error) {
+	var result uint64
+	for _, value := range values {
+		result = gcdMagnitude(result, magnitude(value))
+	}
+	gcd, ok := fromMagnitude(result)
+	if !ok {
+		return 0, fmt.Errorf("%w: gcd of %v", ErrOverflow, values)
+	}
+	return gcd, nil
+}
+
+// Find the Least Common Multiple (LCM) of two values, which is never negative.
+//
+// The LCM of 0 and any value is 0.
+func LCM(a, b int) (int, error) {
+	result, ok := lcmMagnitude(magnitude(a), magnitude(b))
+	if !ok {
+		return 0, fmt.Errorf("%w: lcm of %v and %v", ErrOverflow, a, b)
+	}
+	return result, nil
+}
+
+func lcmMagnitude(a, b uint64) (int, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	hi, lo := bits.Mul64(a/gcdMagnitude(a, b), b)
+	if hi != 0 {
+		return 0, false
+	}
+	return fromMagnitude(lo)
+}
+
+// Find the LCM of all values, or 1 if there are none.
+//
+// Use BigLCMOfSlice if the LCM may not fit in an int.
+func LCMOfSlice(values []int) (int, error) {
+	result := 1
+	for _, value := range values {
+		var ok bool
+		result, ok = lcmMagnitude(uint64(result), magnitude(value))
+		if !ok {
+			return 0, fmt.Errorf("%w: lcm of %v", ErrOverflow, values)
+		}
+	}
+	return result, nil
+}
+
+// Find the remainder of a divided by m, which unlike a%m is never negative for a positive m
+func Mod(a, m int) int {
+	result := a % m
+	if result < 0 {
+		result += m
+	}
+	return result
+}
+
+// Find the floor of the square root of n, which must not be negative
+func ISqrt(n int) int {
+	if n < 0 {
+		panic(fmt.Sprintf("aocmath: square root of negative number %v", n))
+	}
+	// The float estimate is at most one or two away for large n, so nudge it into place
+	result := int(math.Sqrt(float64(n)))
+	for result > 0 && result > n/result {
+		result -= 1
+	}
+	for result+1 <= n/(result+1) {
+		result += 1
+	}
+	return result
+}
@@ -0,0 +1,109 @@
+package aocmath
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestGCDAndLCM(t *testing.T) {
+	tests := []struct {
+		a, b     int
+		gcd, lcm int
+	}{
+		{12, 18, 6, 36},
+		{-12, 18, 6, 36},
+		{7, 13, 1, 91},
+		{0, 5, 5, 0},
+		{0, 0, 0, 0},
+	}
+	for _, test := range tests {
+		if gcd, err := GCD(test.a, test.b); err != nil || gcd != test.gcd {
+			t.Errorf("GCD(%v, %v) = %v, %v, expected %v", test.a, test.b, gcd, err, test.gcd)
+		}
+		if lcm, err := LCM(test.a, test.b); err != nil || lcm != test.lcm {
+			t.Errorf("LCM(%v, %v) = %v, %v, expected %v", test.a, test.b, lcm, err, test.lcm)
+		}
+	}
+
+	if _, err := GCD(math.MinInt, 0); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected GCD of math.MinInt and 0 to overflow, got %v", err)
+	}
+}
+
+func TestLCMOfSlice(t *testing.T) {
+	if lcm, err := LCMOfSlice([]int{3739, 3793, 3923, 4027}); err != nil || lcm != 224046542165867 {
+		t.Errorf("got %v, %v, expected 224046542165867", lcm, err)
+	}
+	if gcd, err := GCDOfSlice([]int{12, -18, 30}); err != nil || gcd != 6 {
+		t.Errorf("got GCD %v, %v, expected 6", gcd, err)
+	}
+
+	// Distinct primes, so the LCM is their product of about 2^80
+	primes := []int{1000003, 1000033, 1000037, 1000039}
+	if _, err := LCMOfSlice(primes); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected LCM of %v to overflow, got %v", primes, err)
+	}
+	expected, _ := new(big.Int).SetString("1000112004278059472142857", 10)
+	if lcm := BigLCMOfSlice(primes); lcm.Cmp(expected) != 0 {
+		t.Errorf("got big LCM %v, expected %v", lcm, expected)
+	}
+}
+
+func TestExtendedGCDAndModInverse(t *testing.T) {
+	for _, pair := range [][2]int{{240, 46}, {-240, 46}, {17, 0}, {0, -5}} {
+		a, b := pair[0], pair[1]
+		gcd, x, y := ExtendedGCD(a, b)
+		if expected, _ := GCD(a, b); gcd != expected || a*x+b*y != gcd {
+			t.Errorf("ExtendedGCD(%v, %v) = %v, %v, %v", a, b, gcd, x, y)
+		}
+	}
+
+	if inverse, err := ModInverse(-3, 11); err != nil || inverse != 7 {
+		t.Errorf("got inverse %v, %v, expected 7", inverse, err)
+	}
+	if _, err := ModInverse(6, 9); !errors.Is(err, ErrNotInvertible) {
+		t.Errorf("expected 6 to have no inverse modulo 9, got %v", err)
+	}
+}
+
+func TestCRT(t *testing.T) {
+	tests := []struct {
+		name     string
+		residues []int
+		moduli   []int
+		result   int
+		modulus  int
+		err      error
+	}{
+		{"Coprime", []int{2, 3, 2}, []int{3, 5, 7}, 23, 105, nil},
+		{"NotCoprime", []int{3, 7}, []int{4, 6}, 7, 12, nil},
+		{"NegativeResidue", []int{-1, -1}, []int{4, 6}, 11, 12, nil},
+		{"Contradiction", []int{1, 2}, []int{4, 6}, 0, 0, ErrNoSolution},
+		{"InvalidModulus", []int{1}, []int{0}, 0, 0, ErrInvalidModulus},
+		{"Overflow", []int{0, 0, 0}, []int{1 << 30, (1 << 31) - 1, (1 << 31) + 1}, 0, 0, ErrOverflow},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, modulus, err := CRT(test.residues, test.moduli)
+			if !errors.Is(err, test.err) || result != test.result || modulus != test.modulus {
+				t.Errorf("got %v, %v, %v, expected %v, %v, %v", result, modulus, err, test.result, test.modulus, test.err)
+			}
+		})
+	}
+}
+
+func TestISqrt(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 3, 4, 15, 16, 17, 1 << 52, (1 << 52) + 1, math.MaxInt} {
+		root := ISqrt(n)
+		square := new(big.Int).Mul(big.NewInt(int64(root)), big.NewInt(int64(root)))
+		nextSquare := new(big.Int).Mul(big.NewInt(int64(root+1)), big.NewInt(int64(root+1)))
+		if square.Cmp(big.NewInt(int64(n))) > 0 || nextSquare.Cmp(big.NewInt(int64(n))) <= 0 {
+			t.Errorf("ISqrt(%v) = %v", n, root)
+		}
+	}
+	if root := ISqrt(math.MaxInt); root != 3037000499 {
+		t.Errorf("got ISqrt(math.MaxInt) = %v, expected 3037000499", root)
+	}
+}
@@ -0,0 +1,23 @@
+package aocmath
+
+import "math/big"
+
+// Find the LCM of two values as LCM does, for values of any size
+func BigLCM(a, b *big.Int) *big.Int {
+	if a.Sign() == 0 || b.Sign() == 0 {
+		return big.NewInt(0)
+	}
+	gcd := new(big.Int).GCD(nil, nil, new(big.Int).Abs(a), new(big.Int).Abs(b))
+	result := new(big.Int).Quo(a, gcd)
+	result.Mul(result, b)
+	return result.Abs(result)
+}
+
+// Find the LCM of all values, or 1 if there are none, however large it is
+func BigLCMOfSlice(values []int) *big.Int {
+	result := big.NewInt(1)
+	for _, value := range values {
+		result = BigLCM(result, big.NewInt(int64(value)))
+	}
+	return result
+}
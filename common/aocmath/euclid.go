@@ -0,0 +1,100 @@
+package aocmath
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// Find the GCD of a and b along with coefficients x and y such that a*x + b*y = gcd.
+//
+// The GCD is never negative, and the coefficients are the smallest possible, so do
+// not overflow for any a and b other than math.MinInt.
+func ExtendedGCD(a, b int) (int, int, int) {
+	oldR, r := a, b
+	oldX, x := 1, 0
+	oldY, y := 0, 1
+	for r != 0 {
+		quotient := oldR / r
+		oldR, r = r, oldR-quotient*r
+		oldX, x = x, oldX-quotient*x
+		oldY, y = y, oldY-quotient*y
+	}
+	if oldR < 0 {
+		return -oldR, -oldX, -oldY
+	}
+	return oldR, oldX, oldY
+}
+
+// Find x in [0, m) such that a*x is 1 modulo m.
+//
+// Fails if a and m share a factor.
+func ModInverse(a, m int) (int, error) {
+	if m <= 0 {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidModulus, m)
+	}
+	gcd, x, _ := ExtendedGCD(Mod(a, m), m)
+	if gcd != 1 {
+		return 0, fmt.Errorf("%w: %v modulo %v share the factor %v", ErrNotInvertible, a, m, gcd)
+	}
+	return Mod(x, m), nil
+}
+
+// Solve the Chinese Remainder Theorem (CRT): find x such that x is residues[i] modulo
+// moduli[i] for every i. The moduli need not be coprime.
+//
+// Returns the smallest non-negative x and the LCM of the moduli, as every x plus a multiple
+// of this is also a solution. For example, the first time several cycles with offsets
+// line up. Fails with ErrNoSolution if the congruences contradict each other, or
+// ErrOverflow if the LCM of the moduli does not fit in an int, in which case use BigCRT.
+func CRT(residues, moduli []int) (int, int, error) {
+	bigResidues := make([]*big.Int, len(residues))
+	for i, residue := range residues {
+		bigResidues[i] = big.NewInt(int64(residue))
+	}
+	bigModuli := make([]*big.Int, len(moduli))
+	for i, modulus := range moduli {
+		bigModuli[i] = big.NewInt(int64(modulus))
+	}
+
+	result, modulus, err := BigCRT(bigResidues, bigModuli)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !modulus.IsInt64() {
+		return 0, 0, fmt.Errorf("%w: lcm of moduli %v", ErrOverflow, moduli)
+	}
+	return int(result.Int64()), int(modulus.Int64()), nil
+}
+
+// Solve the CRT as CRT does, for values of any size
+func BigCRT(residues, moduli []*big.Int) (*big.Int, *big.Int, error) {
+	if len(residues) != len(moduli) {
+		return nil, nil, fmt.Errorf("got %v residues but %v moduli", len(residues), len(moduli))
+	}
+
+	result := big.NewInt(0)
+	modulus := big.NewInt(1)
+	for i := range residues {
+		if moduli[i].Sign() <= 0 {
+			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidModulus, moduli[i])
+		}
+
+		// Find k such that result + modulus*k is residues[i] modulo moduli[i],
+		// i.e. modulus*k = difference, solvable only if the GCD divides the difference
+		coefficient := new(big.Int)
+		gcd := new(big.Int).GCD(coefficient, nil, modulus, moduli[i])
+		difference := new(big.Int).Sub(residues[i], result)
+		quotient, remainder := new(big.Int).QuoRem(difference, gcd, new(big.Int))
+		if remainder.Sign() != 0 {
+			return nil, nil, fmt.Errorf("%w: x = %v mod %v contradicts the earlier congruences", ErrNoSolution, residues[i], moduli[i])
+		}
+		reducedModulus := new(big.Int).Quo(moduli[i], gcd)
+		k := quotient.Mul(quotient, coefficient)
+		k.Mod(k, reducedModulus)
+
+		result.Add(result, k.Mul(k, modulus))
+		modulus.Mul(modulus, reducedModulus)
+		result.Mod(result, modulus)
+	}
+	return result, modulus, nil
+}
@@ -0,0 +1,208 @@
+// Table driven tests and benchmarks of ProcessInput functions against golden files.
+//
+// Each part package keeps its examples in a testdata directory. An example
+// is a pair of files: the input (e.g. testdata/example1.txt) and the expected
+// answer alongside it with the same name (e.g. testdata/example1.expected).
+// Adding a new example only needs these two files, no Go code.
+package golden
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"hmcalister/aocCommon/answer"
+	"hmcalister/aocCommon/input"
+	"hmcalister/aocCommon/parse"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	TESTDATA_DIR       = "testdata"
+	PUZZLE_INPUT_PATH  = "../puzzleInput"
+	INPUT_EXTENSION    = ".txt"
+	EXPECTED_EXTENSION = ".expected"
+)
+
+// A single input file and the answer the solver is expected to give for it
+type Example struct {
+	Name         string
+	InputPath    string
+	ExpectedPath string
+}
+
+// Find all examples in the given directory, sorted by name
+//
+// Input files without a matching expected file are ignored
+func FindExamples(dir string) ([]Example, error) {
+	inputPaths, err := filepath.Glob(filepath.Join(dir, "*"+INPUT_EXTENSION))
+	if err != nil {
+		return nil, err
+	}
+
+	examples := make([]Example, 0, len(inputPaths))
+	for _, inputPath := range inputPaths {
+		name := strings.TrimSuffix(filepath.Base(inputPath), INPUT_EXTENSION)
+		expectedPath := strings.TrimSuffix(inputPath, INPUT_EXTENSION) + EXPECTED_EXTENSION
+		if _, err := os.Stat(expectedPath); err != nil {
+			continue
+		}
+		examples = append(examples, Example{
+			Name:         name,
+			InputPath:    inputPath,
+			ExpectedPath: expectedPath,
+		})
+	}
+	return examples, nil
+}
+
+// Read the expected answer of an example, ignoring surrounding whitespace
+func (example Example) Expected() (string, error) {
+	expected, err := os.ReadFile(example.ExpectedPath)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(expected)), nil
+}
+
+// Read every non-blank line of the example inputs in the given directory, in order,
+// such as to seed the corpus of a fuzz test. Inputs without an expected answer are included.
+func ExampleLines(dir string) ([]string, error) {
+	inputPaths, err := filepath.Glob(filepath.Join(dir, "*"+INPUT_EXTENSION))
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]string, 0)
+	for _, inputPath := range inputPaths {
+		file, err := os.Open(inputPath)
+		if err != nil {
+			return nil, err
+		}
+		inputLines, err := input.Lines(file).Collect()
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range inputLines {
+			if len(line) > 0 {
+				lines = append(lines, line)
+			}
+		}
+	}
+	return lines, nil
+}
+
+// Fail a fuzz test if parsing its input failed with anything other than a parse error,
+// as every malformed line should be reported with its location
+func CheckParseError(t *testing.T, input string, err error) {
+	t.Helper()
+	var parseErr *parse.ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("parsing %q failed without a parse error: %v", input, err)
+	}
+}
+
+// Run the solver on the input of an example
+func (example Example) Solve(solver answer.SolverFunc) (string, error) {
+	file, err := os.Open(example.InputPath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	result, err := solver(input.NewScanner(file))
+	if err != nil {
+		return "", err
+	}
+	return result.String(), nil
+}
+
+// Test the solver against every example in the testdata directory of the
+// calling package, as a subtest per example.
+//
+// Skips the test if no examples exist. Logging is disabled until the test ends.
+func TestSolver[R answer.Result](t *testing.T, processInput func(fileScanner *bufio.Scanner) (R, error)) {
+	t.Helper()
+	solver := answer.Adapt(processInput)
+	previousLevel := zerolog.GlobalLevel()
+	t.Cleanup(func() { zerolog.SetGlobalLevel(previousLevel) })
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+
+	examples, err := FindExamples(TESTDATA_DIR)
+	if err != nil {
+		t.Fatalf("error finding examples: %v", err)
+	}
+	if len(examples) == 0 {
+		t.Skipf("no examples found in %v", TESTDATA_DIR)
+	}
+
+	for _, example := range examples {
+		example := example
+		t.Run(example.Name, func(t *testing.T) {
+			expected, err := example.Expected()
+			if err != nil {
+				t.Fatalf("error reading expected answer: %v", err)
+			}
+
+			result, err := example.Solve(solver)
+			if err != nil {
+				t.Fatalf("error processing input: %v", err)
+			}
+			if result != expected {
+				t.Errorf("got %v, expected %v", result, expected)
+			}
+		})
+	}
+}
+
+// Benchmark the solver against every example in the testdata directory of the
+// calling package, as well as the puzzle input of the day if present, as a
+// sub-benchmark per input.
+//
+// Inputs are read into memory before timing starts, so only ProcessInput is measured.
+// Logging is disabled until the benchmark ends.
+func BenchmarkSolver[R answer.Result](b *testing.B, solver func(fileScanner *bufio.Scanner) (R, error)) {
+	b.Helper()
+	previousLevel := zerolog.GlobalLevel()
+	b.Cleanup(func() { zerolog.SetGlobalLevel(previousLevel) })
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+
+	examples, err := FindExamples(TESTDATA_DIR)
+	if err != nil {
+		b.Fatalf("error finding examples: %v", err)
+	}
+	inputPaths := make(map[string]string)
+	inputNames := make([]string, 0, len(examples)+1)
+	for _, example := range examples {
+		inputPaths[example.Name] = example.InputPath
+		inputNames = append(inputNames, example.Name)
+	}
+	if _, err := os.Stat(PUZZLE_INPUT_PATH); err == nil {
+		inputPaths["puzzleInput"] = PUZZLE_INPUT_PATH
+		inputNames = append(inputNames, "puzzleInput")
+	}
+	if len(inputNames) == 0 {
+		b.Skipf("no inputs found to benchmark")
+	}
+
+	for _, inputName := range inputNames {
+		inputData, err := os.ReadFile(inputPaths[inputName])
+		if err != nil {
+			b.Fatalf("error reading input %v: %v", inputName, err)
+		}
+
+		b.Run(inputName, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i += 1 {
+				if _, err := solver(input.NewScanner(bytes.NewReader(inputData))); err != nil {
+					b.Fatalf("error processing input: %v", err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package graphalg
+
+// Find the Strongly Connected Components (SCCs) of the graph: the largest groups of
+// vertices that can each reach every other vertex of their group.
+//
+// Uses Tarjan's algorithm, giving the components in reverse topological order, so no
+// component has an edge to a component after it.
+func StronglyConnectedComponents[V comparable](graph *Graph[V]) [][]V {
+	index := make(map[V]int)
+	lowLink := make(map[V]int)
+	onStack := make(map[V]bool)
+	stack := make([]V, 0)
+	components := make([][]V, 0)
+
+	var strongConnect func(vertex V)
+	strongConnect = func(vertex V) {
+		index[vertex] = len(index)
+		lowLink[vertex] = index[vertex]
+		stack = append(stack, vertex)
+		onStack[vertex] = true
+
+		for _, edge := range graph.Neighbors(vertex) {
+			if _, visited := index[edge.To]; !visited {
+				strongConnect(edge.To)
+				lowLink[vertex] = min(lowLink[vertex], lowLink[edge.To])
+			} else if onStack[edge.To] {
+				lowLink[vertex] = min(lowLink[vertex], index[edge.To])
+			}
+		}
+
+		// The vertex is the root of a component, which is everything above it on the stack
+		if lowLink[vertex] == index[vertex] {
+			component := make([]V, 0)
+			for {
+				member := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[member] = false
+				component = append(component, member)
+				if member == vertex {
+					break
+				}
+			}
+			components = append(components, component)
+		}
+	}
+
+	for _, vertex := range graph.Vertices() {
+		if _, visited := index[vertex]; !visited {
+			strongConnect(vertex)
+		}
+	}
+	return components
+}
+
+type cutCandidate struct {
+	vertex int
+	weight int
+}
+
+// Find the lightest set of edges whose removal splits the graph in two, returning their
+// total weight and the vertices of one side.
+//
+// The graph must be undirected, with each edge added both ways. Uses the Stoer-Wagner
+// algorithm, so unlike random contraction the cut is always the minimum.
+func MinimumCut[V comparable](graph *Graph[V]) (int, []V) {
+	vertices := graph.Vertices()
+	if len(vertices) < 2 {
+		return 0, nil
+	}
+
+	// Vertices are merged together as the algorithm runs, so work on indices
+	adjacency := make([]map[int]int, len(vertices))
+	members := make([][]int, len(vertices))
+	active := make([]int, len(vertices))
+	for i, vertex := range vertices {
+		adjacency[i] = make(map[int]int)
+		for _, edge := range graph.Neighbors(vertex) {
+			if edge.To != vertex {
+				adjacency[i][graph.vertexIndex[edge.To]] = edge.Weight
+			}
+		}
+		members[i] = []int{i}
+		active[i] = i
+	}
+
+	bestWeight := -1
+	var bestSide []int
+	for len(active) > 1 {
+		// Grow a set from the first active vertex, adding the vertex most tightly connected to it each time
+		inSet := make(map[int]bool)
+		connection := make(map[int]int)
+		candidates := NewHeap(func(a, b cutCandidate) bool {
+			return a.weight > b.weight
+		})
+		for _, vertex := range active {
+			candidates.Push(cutCandidate{vertex, 0})
+		}
+
+		previous, last := -1, -1
+		for candidates.Len() > 0 {
+			candidate := candidates.Pop()
+			if inSet[candidate.vertex] || candidate.weight != connection[candidate.vertex] {
+				continue
+			}
+			inSet[candidate.vertex] = true
+			previous, last = last, candidate.vertex
+			for neighbor, weight := range adjacency[candidate.vertex] {
+				if !inSet[neighbor] {
+					connection[neighbor] += weight
+					candidates.Push(cutCandidate{neighbor, connection[neighbor]})
+				}
+			}
+		}
+
+		// The last vertex added is only connected to the rest by the cut of this phase
+		if bestWeight < 0 || connection[last] < bestWeight {
+			bestWeight = connection[last]
+			bestSide = append([]int{}, members[last]...)
+		}
+
+		// Merge the last vertex into the one before it
+		for neighbor, weight := range adjacency[last] {
+			delete(adjacency[neighbor], last)
+			if neighbor != previous {
+				adjacency[previous][neighbor] += weight
+				adjacency[neighbor][previous] += weight
+			}
+		}
+		adjacency[last] = nil
+		members[previous] = append(members[previous], members[last]...)
+		for i, vertex := range active {
+			if vertex == last {
+				active = append(active[:i], active[i+1:]...)
+				break
+			}
+		}
+	}
+
+	side := make([]V, len(bestSide))
+	for i, vertexIndex := range bestSide {
+		side[i] = vertices[vertexIndex]
+	}
+	return bestWeight, side
+}
@@ -0,0 +1,65 @@
+package graphalg
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrCycle = errors.New("graph has a cycle")
+
+// Order the vertices so every edge goes from an earlier vertex to a later one.
+//
+// Fails with ErrCycle if there is no such order.
+func TopologicalSort[V comparable](graph *Graph[V]) ([]V, error) {
+	inDegree := make(map[V]int)
+	for _, vertex := range graph.Vertices() {
+		for _, edge := range graph.Neighbors(vertex) {
+			inDegree[edge.To] += 1
+		}
+	}
+
+	order := make([]V, 0, graph.Order())
+	for _, vertex := range graph.Vertices() {
+		if inDegree[vertex] == 0 {
+			order = append(order, vertex)
+		}
+	}
+	// Order doubles as the queue of vertices with no remaining incoming edges
+	for i := 0; i < len(order); i += 1 {
+		for _, edge := range graph.Neighbors(order[i]) {
+			inDegree[edge.To] -= 1
+			if inDegree[edge.To] == 0 {
+				order = append(order, edge.To)
+			}
+		}
+	}
+
+	if len(order) != graph.Order() {
+		return nil, fmt.Errorf("%w: only %v of %v vertices could be ordered", ErrCycle, len(order), graph.Order())
+	}
+	return order, nil
+}
+
+// Find the total weight of the heaviest path from the start to each vertex reachable from it.
+//
+// Fails with ErrCycle if the graph is not a Directed Acyclic Graph (DAG), as paths could then be endless.
+func LongestPathsDAG[V comparable](graph *Graph[V], start V) (map[V]int, error) {
+	order, err := TopologicalSort(graph)
+	if err != nil {
+		return nil, err
+	}
+
+	longest := map[V]int{start: 0}
+	for _, vertex := range order {
+		distance, reachable := longest[vertex]
+		if !reachable {
+			continue
+		}
+		for _, edge := range graph.Neighbors(vertex) {
+			if known, ok := longest[edge.To]; !ok || known < distance+edge.Weight {
+				longest[edge.To] = distance + edge.Weight
+			}
+		}
+	}
+	return longest, nil
+}
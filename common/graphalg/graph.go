@@ -0,0 +1,94 @@
+package graphalg
+
+// An edge to a state or vertex, with the cost of taking it
+type Edge[S comparable] struct {
+	To     S
+	Weight int
+}
+
+// A graph given by the edges leaving each state, so states can be generated as they
+// are reached rather than built up front, such as a position along with its direction
+type ImplicitGraph[S comparable] interface {
+	Neighbors(state S) []Edge[S]
+}
+
+// Adapt a function to an ImplicitGraph
+type NeighborsFunc[S comparable] func(state S) []Edge[S]
+
+func (f NeighborsFunc[S]) Neighbors(state S) []Edge[S] {
+	return f(state)
+}
+
+// A directed, weighted graph held in memory. Undirected graphs add each edge both ways.
+//
+// Vertices and edges are kept in the order they are added, so algorithms over the
+// graph give the same result on each run.
+type Graph[V comparable] struct {
+	vertices    []V
+	vertexIndex map[V]int
+	edges       [][]Edge[V]
+}
+
+func NewGraph[V comparable]() *Graph[V] {
+	return &Graph[V]{
+		vertices:    make([]V, 0),
+		vertexIndex: make(map[V]int),
+		edges:       make([][]Edge[V], 0),
+	}
+}
+
+// Add a vertex, returning false if it is already present
+func (graph *Graph[V]) AddVertex(vertex V) bool {
+	if graph.HasVertex(vertex) {
+		return false
+	}
+	graph.vertexIndex[vertex] = len(graph.vertices)
+	graph.vertices = append(graph.vertices, vertex)
+	graph.edges = append(graph.edges, make([]Edge[V], 0))
+	return true
+}
+
+func (graph *Graph[V]) HasVertex(vertex V) bool {
+	_, ok := graph.vertexIndex[vertex]
+	return ok
+}
+
+// Add an edge between two vertices, adding the vertices if needed.
+//
+// If the edge is already present its weight is replaced.
+func (graph *Graph[V]) AddEdge(from V, to V, weight int) {
+	graph.AddVertex(from)
+	graph.AddVertex(to)
+	fromEdges := graph.edges[graph.vertexIndex[from]]
+	for i, edge := range fromEdges {
+		if edge.To == to {
+			fromEdges[i].Weight = weight
+			return
+		}
+	}
+	graph.edges[graph.vertexIndex[from]] = append(fromEdges, Edge[V]{To: to, Weight: weight})
+}
+
+// Add an edge in both directions
+func (graph *Graph[V]) AddUndirectedEdge(a V, b V, weight int) {
+	graph.AddEdge(a, b, weight)
+	graph.AddEdge(b, a, weight)
+}
+
+// All vertices, in the order they were added
+func (graph *Graph[V]) Vertices() []V {
+	return graph.vertices
+}
+
+func (graph *Graph[V]) Order() int {
+	return len(graph.vertices)
+}
+
+// The edges leaving a vertex, or nil if the vertex is not present
+func (graph *Graph[V]) Neighbors(vertex V) []Edge[V] {
+	index, ok := graph.vertexIndex[vertex]
+	if !ok {
+		return nil
+	}
+	return graph.edges[index]
+}
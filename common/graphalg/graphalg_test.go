@@ -0,0 +1,132 @@
+package graphalg
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestHeap(t *testing.T) {
+	heap := NewHeap(func(a, b int) bool { return a < b })
+	for _, value := range []int{5, 1, 4, 1, 5, 9, 2, 6} {
+		heap.Push(value)
+	}
+	if least := heap.Peek(); least != 1 {
+		t.Errorf("got least %v, expected 1", least)
+	}
+	popped := make([]int, 0)
+	for heap.Len() > 0 {
+		popped = append(popped, heap.Pop())
+	}
+	if !slices.Equal(popped, []int{1, 1, 2, 4, 5, 5, 6, 9}) {
+		t.Errorf("got %v, expected sorted order", popped)
+	}
+}
+
+type point struct {
+	X, Y int
+}
+
+// A 5x5 grid where moving right costs 1 and moving down costs 3, with a wall blocking x = 2 for y < 4
+func gridGraph() ImplicitGraph[point] {
+	return NeighborsFunc[point](func(p point) []Edge[point] {
+		edges := make([]Edge[point], 0)
+		for _, next := range []Edge[point]{{point{p.X + 1, p.Y}, 1}, {point{p.X, p.Y + 1}, 3}} {
+			if next.To.X < 5 && next.To.Y < 5 && !(next.To.X == 2 && next.To.Y < 4) {
+				edges = append(edges, next)
+			}
+		}
+		return edges
+	})
+}
+
+func TestDijkstraAndAStar(t *testing.T) {
+	isGoal := func(p point) bool { return p == point{4, 4} }
+	manhattan := func(p point) int { return (4 - p.X) + 3*(4-p.Y) }
+
+	for name, search := range map[string]func() (Path[point], bool){
+		"Dijkstra": func() (Path[point], bool) { return Dijkstra(gridGraph(), []point{{0, 0}}, isGoal) },
+		"AStar":    func() (Path[point], bool) { return AStar(gridGraph(), []point{{0, 0}}, isGoal, manhattan) },
+	} {
+		path, ok := search()
+		if !ok || path.Cost != 16 || len(path.States) != 9 {
+			t.Errorf("%v: got %v, %v, expected cost 16 over 9 states", name, path, ok)
+		}
+		if path.States[0] != (point{0, 0}) || path.States[len(path.States)-1] != (point{4, 4}) {
+			t.Errorf("%v: path %v does not run from start to goal", name, path.States)
+		}
+	}
+
+	if _, ok := Dijkstra(gridGraph(), []point{{3, 0}}, func(p point) bool { return p == point{0, 0} }); ok {
+		t.Errorf("expected no path back to the origin")
+	}
+}
+
+func TestBFSAndDFS(t *testing.T) {
+	steps := BFS(gridGraph(), point{0, 0})
+	if len(steps) != 13 || steps[point{4, 4}] != 8 {
+		t.Errorf("got %v reachable states with %v steps to (4, 4), expected 13 and 8", len(steps), steps[point{4, 4}])
+	}
+
+	visited := make([]point, 0)
+	DFS(gridGraph(), point{0, 0}, func(p point) bool {
+		visited = append(visited, p)
+		return len(visited) < 4
+	})
+	if !slices.Equal(visited, []point{{0, 0}, {1, 0}, {1, 1}, {1, 2}}) {
+		t.Errorf("got DFS order %v", visited)
+	}
+}
+
+func newTestGraph(edges [][3]int) *Graph[int] {
+	graph := NewGraph[int]()
+	for _, edge := range edges {
+		graph.AddEdge(edge[0], edge[1], edge[2])
+	}
+	return graph
+}
+
+func TestTopologicalSortAndLongestPaths(t *testing.T) {
+	graph := newTestGraph([][3]int{{1, 2, 1}, {1, 3, 5}, {2, 3, 1}, {2, 4, 7}, {3, 4, 1}})
+	order, err := TopologicalSort(graph)
+	if err != nil || !slices.Equal(order, []int{1, 2, 3, 4}) {
+		t.Errorf("got order %v, %v, expected [1 2 3 4]", order, err)
+	}
+	longest, err := LongestPathsDAG(graph, 2)
+	if err != nil || longest[4] != 7 || longest[3] != 1 {
+		t.Errorf("got longest paths %v, %v", longest, err)
+	}
+	if _, ok := longest[1]; ok {
+		t.Errorf("expected 1 to be unreachable from 2")
+	}
+
+	graph.AddEdge(4, 2, 1)
+	if _, err := TopologicalSort(graph); !errors.Is(err, ErrCycle) {
+		t.Errorf("expected a cycle error, got %v", err)
+	}
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	graph := newTestGraph([][3]int{{1, 2, 1}, {2, 3, 1}, {3, 1, 1}, {3, 4, 1}, {4, 5, 1}, {5, 4, 1}, {6, 5, 1}})
+	components := StronglyConnectedComponents(graph)
+	for _, component := range components {
+		slices.Sort(component)
+	}
+	expected := [][]int{{4, 5}, {1, 2, 3}, {6}}
+	if !slices.EqualFunc(components, expected, slices.Equal[[]int]) {
+		t.Errorf("got components %v, expected %v", components, expected)
+	}
+}
+
+func TestMinimumCut(t *testing.T) {
+	// Two triangles joined by edges 3-4 and 1-6, with 3-4 being the lighter pair
+	graph := NewGraph[int]()
+	for _, edge := range [][3]int{{1, 2, 3}, {2, 3, 3}, {3, 1, 3}, {4, 5, 3}, {5, 6, 3}, {6, 4, 3}, {3, 4, 1}, {1, 6, 2}} {
+		graph.AddUndirectedEdge(edge[0], edge[1], edge[2])
+	}
+	weight, side := MinimumCut(graph)
+	slices.Sort(side)
+	if weight != 3 || !(slices.Equal(side, []int{1, 2, 3}) || slices.Equal(side, []int{4, 5, 6})) {
+		t.Errorf("got cut of weight %v with side %v, expected weight 3 between the triangles", weight, side)
+	}
+}
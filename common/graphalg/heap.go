@@ -0,0 +1,67 @@
+// Graph algorithms shared between days, working either on a Graph held in memory or on
+// an ImplicitGraph whose states are generated as they are reached.
+package graphalg
+
+// A binary heap ordered by a less function, so Pop gives the least item first
+type Heap[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func NewHeap[T any](less func(a, b T) bool) *Heap[T] {
+	return &Heap[T]{
+		items: make([]T, 0),
+		less:  less,
+	}
+}
+
+func (heap *Heap[T]) Len() int {
+	return len(heap.items)
+}
+
+func (heap *Heap[T]) Push(item T) {
+	heap.items = append(heap.items, item)
+	heap.up(len(heap.items) - 1)
+}
+
+// Remove and return the least item. Panics if the heap is empty
+func (heap *Heap[T]) Pop() T {
+	last := len(heap.items) - 1
+	heap.items[0], heap.items[last] = heap.items[last], heap.items[0]
+	item := heap.items[last]
+	heap.items = heap.items[:last]
+	heap.down(0)
+	return item
+}
+
+// Return the least item without removing it. Panics if the heap is empty
+func (heap *Heap[T]) Peek() T {
+	return heap.items[0]
+}
+
+func (heap *Heap[T]) up(index int) {
+	for index > 0 {
+		parent := (index - 1) / 2
+		if !heap.less(heap.items[index], heap.items[parent]) {
+			return
+		}
+		heap.items[index], heap.items[parent] = heap.items[parent], heap.items[index]
+		index = parent
+	}
+}
+
+func (heap *Heap[T]) down(index int) {
+	for {
+		smallest := index
+		for _, child := range []int{2*index + 1, 2*index + 2} {
+			if child < len(heap.items) && heap.less(heap.items[child], heap.items[smallest]) {
+				smallest = child
+			}
+		}
+		if smallest == index {
+			return
+		}
+		heap.items[index], heap.items[smallest] = heap.items[smallest], heap.items[index]
+		index = smallest
+	}
+}
@@ -0,0 +1,126 @@
+package graphalg
+
+import "slices"
+
+// A path through a graph, from a start state to a goal state inclusive
+type Path[S comparable] struct {
+	States []S
+	Cost   int
+}
+
+type searchEntry[S comparable] struct {
+	state S
+	cost  int
+	// Cost plus the heuristic estimate of the remaining cost
+	estimate int
+}
+
+// Find the cheapest path from any of the start states to a state satisfying isGoal.
+//
+// Edge weights must not be negative. Returns false if no goal can be reached.
+func Dijkstra[S comparable](graph ImplicitGraph[S], starts []S, isGoal func(S) bool) (Path[S], bool) {
+	return AStar(graph, starts, isGoal, func(S) int { return 0 })
+}
+
+// Find the cheapest path as Dijkstra does, exploring states in order of their cost plus
+// the heuristic estimate of the cost remaining to a goal.
+//
+// The heuristic must never overestimate, or the path found may not be the cheapest.
+func AStar[S comparable](graph ImplicitGraph[S], starts []S, isGoal func(S) bool, heuristic func(S) int) (Path[S], bool) {
+	bestCost := make(map[S]int)
+	previous := make(map[S]S)
+	visited := make(map[S]bool)
+	queue := NewHeap(func(a, b searchEntry[S]) bool {
+		return a.estimate < b.estimate
+	})
+
+	for _, start := range starts {
+		bestCost[start] = 0
+		queue.Push(searchEntry[S]{state: start, cost: 0, estimate: heuristic(start)})
+	}
+
+	for queue.Len() > 0 {
+		current := queue.Pop()
+		// A state may be queued several times before its cheapest entry is reached
+		if visited[current.state] {
+			continue
+		}
+		visited[current.state] = true
+
+		if isGoal(current.state) {
+			return Path[S]{
+				States: tracePath(previous, current.state),
+				Cost:   current.cost,
+			}, true
+		}
+
+		for _, edge := range graph.Neighbors(current.state) {
+			nextCost := current.cost + edge.Weight
+			if knownCost, ok := bestCost[edge.To]; ok && knownCost <= nextCost {
+				continue
+			}
+			bestCost[edge.To] = nextCost
+			previous[edge.To] = current.state
+			queue.Push(searchEntry[S]{state: edge.To, cost: nextCost, estimate: nextCost + heuristic(edge.To)})
+		}
+	}
+
+	return Path[S]{}, false
+}
+
+// Follow the previous states back from the end to a start, returning the states from start to end
+func tracePath[S comparable](previous map[S]S, end S) []S {
+	path := []S{end}
+	for {
+		state, ok := previous[path[len(path)-1]]
+		if !ok {
+			break
+		}
+		path = append(path, state)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// Find the number of edges on the shortest path from the start to every reachable state, ignoring weights
+func BFS[S comparable](graph ImplicitGraph[S], start S) map[S]int {
+	steps := map[S]int{start: 0}
+	queue := []S{start}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, edge := range graph.Neighbors(current) {
+			if _, ok := steps[edge.To]; ok {
+				continue
+			}
+			steps[edge.To] = steps[current] + 1
+			queue = append(queue, edge.To)
+		}
+	}
+	return steps
+}
+
+// Visit each state reachable from the start once, depth first, stopping early if visit returns false
+func DFS[S comparable](graph ImplicitGraph[S], start S, visit func(S) bool) {
+	visited := make(map[S]bool)
+	stack := []S{start}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+		if !visit(current) {
+			return
+		}
+
+		// Push in reverse so neighbors are visited in the order given
+		neighbors := graph.Neighbors(current)
+		for i := len(neighbors) - 1; i >= 0; i -= 1 {
+			if !visited[neighbors[i].To] {
+				stack = append(stack, neighbors[i].To)
+			}
+		}
+	}
+}
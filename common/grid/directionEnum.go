@@ -0,0 +1,71 @@
+package grid
+
+//go:generate stringer -type=DirectionEnum
+type DirectionEnum int
+
+// The cardinal directions come first, in clockwise order, followed by the diagonals in clockwise order
+const (
+	DIRECTION_UP         DirectionEnum = iota
+	DIRECTION_RIGHT      DirectionEnum = iota
+	DIRECTION_DOWN       DirectionEnum = iota
+	DIRECTION_LEFT       DirectionEnum = iota
+	DIRECTION_UP_RIGHT   DirectionEnum = iota
+	DIRECTION_DOWN_RIGHT DirectionEnum = iota
+	DIRECTION_DOWN_LEFT  DirectionEnum = iota
+	DIRECTION_UP_LEFT    DirectionEnum = iota
+)
+
+var (
+	// The 4-neighborhood, in clockwise order from up
+	CARDINAL_DIRECTIONS = []DirectionEnum{DIRECTION_UP, DIRECTION_RIGHT, DIRECTION_DOWN, DIRECTION_LEFT}
+
+	// The 8-neighborhood, in clockwise order from up
+	ALL_DIRECTIONS = []DirectionEnum{
+		DIRECTION_UP, DIRECTION_UP_RIGHT,
+		DIRECTION_RIGHT, DIRECTION_DOWN_RIGHT,
+		DIRECTION_DOWN, DIRECTION_DOWN_LEFT,
+		DIRECTION_LEFT, DIRECTION_UP_LEFT,
+	}
+
+	directionOffsets = map[DirectionEnum]Point{
+		DIRECTION_UP:         {0, -1},
+		DIRECTION_RIGHT:      {1, 0},
+		DIRECTION_DOWN:       {0, 1},
+		DIRECTION_LEFT:       {-1, 0},
+		DIRECTION_UP_RIGHT:   {1, -1},
+		DIRECTION_DOWN_RIGHT: {1, 1},
+		DIRECTION_DOWN_LEFT:  {-1, 1},
+		DIRECTION_UP_LEFT:    {-1, -1},
+	}
+)
+
+// The change in position from a single step in this direction. Note y increases downwards.
+func (direction DirectionEnum) Offset() Point {
+	return directionOffsets[direction]
+}
+
+func (direction DirectionEnum) IsDiagonal() bool {
+	return direction >= DIRECTION_UP_RIGHT
+}
+
+// Turn a quarter turn clockwise, e.g. up to right or up-right to down-right
+func (direction DirectionEnum) TurnClockwise() DirectionEnum {
+	return direction.rotate(1)
+}
+
+// Turn a quarter turn counterclockwise, e.g. up to left or up-right to up-left
+func (direction DirectionEnum) TurnCounterClockwise() DirectionEnum {
+	return direction.rotate(3)
+}
+
+func (direction DirectionEnum) Opposite() DirectionEnum {
+	return direction.rotate(2)
+}
+
+// Rotate by the given number of quarter turns clockwise, staying within the cardinal or diagonal directions
+func (direction DirectionEnum) rotate(quarterTurns int) DirectionEnum {
+	if direction.IsDiagonal() {
+		return DIRECTION_UP_RIGHT + (direction-DIRECTION_UP_RIGHT+DirectionEnum(quarterTurns))%4
+	}
+	return (direction + DirectionEnum(quarterTurns)) % 4
+}
@@ -1,6 +1,6 @@
 // Code generated by "stringer -type=DirectionEnum"; DO NOT EDIT.
 
-package lib
+package grid
 
 import "strconv"
 
@@ -12,15 +12,20 @@ func _() {
 	_ = x[DIRECTION_RIGHT-1]
 	_ = x[DIRECTION_DOWN-2]
 	_ = x[DIRECTION_LEFT-3]
+	_ = x[DIRECTION_UP_RIGHT-4]
+	_ = x[DIRECTION_DOWN_RIGHT-5]
+	_ = x[DIRECTION_DOWN_LEFT-6]
+	_ = x[DIRECTION_UP_LEFT-7]
 }
 
-const _DirectionEnum_name = "DIRECTION_UPDIRECTION_RIGHTDIRECTION_DOWNDIRECTION_LEFT"
+const _DirectionEnum_name = "DIRECTION_UPDIRECTION_RIGHTDIRECTION_DOWNDIRECTION_LEFTDIRECTION_UP_RIGHTDIRECTION_DOWN_RIGHTDIRECTION_DOWN_LEFTDIRECTION_UP_LEFT"
 
-var _DirectionEnum_index = [...]uint8{0, 12, 27, 41, 55}
+var _DirectionEnum_index = [...]uint8{0, 12, 27, 41, 55, 73, 93, 112, 129}
 
 func (i DirectionEnum) String() string {
-	if i < 0 || i >= DirectionEnum(len(_DirectionEnum_index)-1) {
+	idx := int(i) - 0
+	if i < 0 || idx >= len(_DirectionEnum_index)-1 {
 		return "DirectionEnum(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
-	return _DirectionEnum_name[_DirectionEnum_index[i]:_DirectionEnum_index[i+1]]
+	return _DirectionEnum_name[_DirectionEnum_index[idx]:_DirectionEnum_index[idx+1]]
 }
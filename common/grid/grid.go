@@ -0,0 +1,259 @@
+// A generic two dimensional grid, as used by the many puzzles with a map of characters as input.
+package grid
+
+import (
+	"bufio"
+	"errors"
+	"hmcalister/aocCommon/parse"
+	"strings"
+)
+
+// A rectangular grid of cells, stored row by row
+type Grid[T any] struct {
+	Width  int
+	Height int
+	cells  []T
+}
+
+// Create a grid of the given size, with every cell the zero value of T
+func New[T any](width int, height int) *Grid[T] {
+	return &Grid[T]{
+		Width:  width,
+		Height: height,
+		cells:  make([]T, width*height),
+	}
+}
+
+// Create a grid from rows of cells, which must all be the same length
+func FromRows[T any](rows [][]T) (*Grid[T], error) {
+	if len(rows) == 0 {
+		return New[T](0, 0), nil
+	}
+
+	grid := New[T](len(rows[0]), len(rows))
+	for y, row := range rows {
+		if len(row) != grid.Width {
+			return nil, errors.New("rows of grid are not all the same length")
+		}
+		copy(grid.cells[y*grid.Width:], row)
+	}
+	return grid, nil
+}
+
+// Parse a grid from lines of text, converting each rune to a cell with parseRune
+//
+// Errors from parseRune, as well as lines of differing lengths, are returned as a
+// *parse.ParseError located using day and the line number of the first line.
+func FromLines[T any](day int, lines []string, firstLineNumber int, parseRune func(r rune) (T, error)) (*Grid[T], error) {
+	if len(lines) == 0 {
+		return nil, parse.Errorf(day, 0, 0, "", "grid is empty")
+	}
+
+	width := len([]rune(lines[0]))
+	grid := New[T](width, len(lines))
+	for y, line := range lines {
+		lineNumber := firstLineNumber + y
+		lineRunes := []rune(line)
+		if len(lineRunes) != width {
+			return nil, parse.Errorf(day, lineNumber, 0, line, "line has length %v, expected %v", len(lineRunes), width)
+		}
+		for x, r := range lineRunes {
+			cell, err := parseRune(r)
+			if err != nil {
+				return nil, parse.NewError(day, lineNumber, x+1, string(r), err)
+			}
+			grid.Set(Point{x, y}, cell)
+		}
+	}
+	return grid, nil
+}
+
+// Parse a grid from every remaining line of the scanner, as in FromLines
+func Parse[T any](day int, fileScanner *bufio.Scanner, parseRune func(r rune) (T, error)) (*Grid[T], error) {
+	lines := make([]string, 0)
+	for fileScanner.Scan() {
+		lines = append(lines, fileScanner.Text())
+	}
+	return FromLines(day, lines, 1, parseRune)
+}
+
+// Parse a grid of the runes in every remaining line of the scanner
+func ParseRunes(day int, fileScanner *bufio.Scanner) (*Grid[rune], error) {
+	return Parse(day, fileScanner, func(r rune) (rune, error) {
+		return r, nil
+	})
+}
+
+func (grid *Grid[T]) InBounds(point Point) bool {
+	return 0 <= point.X && point.X < grid.Width && 0 <= point.Y && point.Y < grid.Height
+}
+
+// The index of the point in the cells of the grid, i.e. y*Width + x
+func (grid *Grid[T]) LinearIndex(point Point) int {
+	return point.Y*grid.Width + point.X
+}
+
+// The point at the given index of the cells of the grid, the inverse of LinearIndex
+func (grid *Grid[T]) PointFromLinearIndex(index int) Point {
+	return Point{
+		X: index % grid.Width,
+		Y: index / grid.Width,
+	}
+}
+
+// Get the cell at the point, which must be in bounds
+func (grid *Grid[T]) Get(point Point) T {
+	if !grid.InBounds(point) {
+		panic("grid: point " + point.String() + " out of bounds")
+	}
+	return grid.cells[grid.LinearIndex(point)]
+}
+
+// Get the cell at the point, or false if the point is out of bounds
+func (grid *Grid[T]) Lookup(point Point) (T, bool) {
+	if !grid.InBounds(point) {
+		var zero T
+		return zero, false
+	}
+	return grid.cells[grid.LinearIndex(point)], true
+}
+
+// Set the cell at the point, which must be in bounds
+func (grid *Grid[T]) Set(point Point, value T) {
+	if !grid.InBounds(point) {
+		panic("grid: point " + point.String() + " out of bounds")
+	}
+	grid.cells[grid.LinearIndex(point)] = value
+}
+
+// Set every cell of the grid to the value
+func (grid *Grid[T]) Fill(value T) {
+	for index := range grid.cells {
+		grid.cells[index] = value
+	}
+}
+
+// Map any point onto the grid, treating the grid as infinitely repeating in every direction
+func (grid *Grid[T]) Wrap(point Point) Point {
+	return Point{
+		X: ((point.X % grid.Width) + grid.Width) % grid.Width,
+		Y: ((point.Y % grid.Height) + grid.Height) % grid.Height,
+	}
+}
+
+// Get the cell at any point, treating the grid as infinitely repeating in every direction
+func (grid *Grid[T]) GetWrapped(point Point) T {
+	return grid.cells[grid.LinearIndex(grid.Wrap(point))]
+}
+
+// The neighbors of the point in each of the given directions, leaving out those out of bounds
+func (grid *Grid[T]) Neighbors(point Point, directions []DirectionEnum) []Point {
+	neighbors := make([]Point, 0, len(directions))
+	for _, direction := range directions {
+		neighbor := point.Move(direction)
+		if grid.InBounds(neighbor) {
+			neighbors = append(neighbors, neighbor)
+		}
+	}
+	return neighbors
+}
+
+// The points along the edge of the grid on the side of the given cardinal direction,
+// in order of increasing x or y
+func (grid *Grid[T]) Edge(direction DirectionEnum) []Point {
+	edge := make([]Point, 0)
+	switch direction {
+	case DIRECTION_UP, DIRECTION_DOWN:
+		y := 0
+		if direction == DIRECTION_DOWN {
+			y = grid.Height - 1
+		}
+		for x := 0; x < grid.Width; x += 1 {
+			edge = append(edge, Point{x, y})
+		}
+	case DIRECTION_LEFT, DIRECTION_RIGHT:
+		x := 0
+		if direction == DIRECTION_RIGHT {
+			x = grid.Width - 1
+		}
+		for y := 0; y < grid.Height; y += 1 {
+			edge = append(edge, Point{x, y})
+		}
+	}
+	return edge
+}
+
+// All points whose cell matches the predicate, in row order
+func (grid *Grid[T]) Find(predicate func(value T) bool) []Point {
+	points := make([]Point, 0)
+	for index, cell := range grid.cells {
+		if predicate(cell) {
+			points = append(points, grid.PointFromLinearIndex(index))
+		}
+	}
+	return points
+}
+
+// The cells of row y. This shares storage with the grid, so changes are reflected in both.
+func (grid *Grid[T]) Row(y int) []T {
+	return grid.cells[y*grid.Width : (y+1)*grid.Width]
+}
+
+// A copy of the cells of column x
+func (grid *Grid[T]) Column(x int) []T {
+	column := make([]T, grid.Height)
+	for y := 0; y < grid.Height; y += 1 {
+		column[y] = grid.cells[y*grid.Width+x]
+	}
+	return column
+}
+
+func (grid *Grid[T]) Clone() *Grid[T] {
+	clone := New[T](grid.Width, grid.Height)
+	copy(clone.cells, grid.cells)
+	return clone
+}
+
+// Swap the rows and columns of the grid, reflecting it in the leading diagonal
+func (grid *Grid[T]) Transpose() *Grid[T] {
+	return grid.remap(grid.Height, grid.Width, func(point Point) Point {
+		return Point{point.Y, point.X}
+	})
+}
+
+// Rotate the grid a quarter turn clockwise
+func (grid *Grid[T]) RotateClockwise() *Grid[T] {
+	return grid.remap(grid.Height, grid.Width, func(point Point) Point {
+		return Point{grid.Height - 1 - point.Y, point.X}
+	})
+}
+
+// Rotate the grid a quarter turn counterclockwise
+func (grid *Grid[T]) RotateCounterClockwise() *Grid[T] {
+	return grid.remap(grid.Height, grid.Width, func(point Point) Point {
+		return Point{point.Y, grid.Width - 1 - point.X}
+	})
+}
+
+// Create a new grid of the given size, moving each cell of this grid to the point given by destination
+func (grid *Grid[T]) remap(width int, height int, destination func(point Point) Point) *Grid[T] {
+	remapped := New[T](width, height)
+	for index, cell := range grid.cells {
+		remapped.Set(destination(grid.PointFromLinearIndex(index)), cell)
+	}
+	return remapped
+}
+
+// Draw the grid as lines of text, converting each cell to a rune with formatCell
+func (grid *Grid[T]) Format(formatCell func(value T) rune) string {
+	var builder strings.Builder
+	for y := 0; y < grid.Height; y += 1 {
+		if y > 0 {
+			builder.WriteRune('\n')
+		}
+		for _, cell := range grid.Row(y) {
+			builder.WriteRune(formatCell(cell))
+		}
+	}
+	return builder.String()
+}
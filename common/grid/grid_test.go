@@ -0,0 +1,125 @@
+package grid
+
+import (
+	"bufio"
+	"errors"
+	"hmcalister/aocCommon/parse"
+	"strings"
+	"testing"
+)
+
+func formatRune(r rune) rune {
+	return r
+}
+
+func TestParseRunes(t *testing.T) {
+	grid, err := ParseRunes(1, bufio.NewScanner(strings.NewReader("ab\ncd\nef\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if grid.Width != 2 || grid.Height != 3 {
+		t.Fatalf("got size %vx%v, expected 2x3", grid.Width, grid.Height)
+	}
+	if r := grid.Get(Point{1, 2}); r != 'f' {
+		t.Errorf("got %q at (1, 2), expected 'f'", r)
+	}
+	if _, ok := grid.Lookup(Point{2, 0}); ok {
+		t.Errorf("expected (2, 0) to be out of bounds")
+	}
+	if formatted := grid.Format(formatRune); formatted != "ab\ncd\nef" {
+		t.Errorf("got formatted grid %q", formatted)
+	}
+
+	_, err = ParseRunes(1, bufio.NewScanner(strings.NewReader("ab\nc\n")))
+	var parseErr *parse.ParseError
+	if !errors.As(err, &parseErr) || parseErr.Line != 2 {
+		t.Errorf("got error %v, expected a ParseError on line 2", err)
+	}
+
+	_, err = Parse(1, bufio.NewScanner(strings.NewReader("..\n.x\n")), func(r rune) (bool, error) {
+		if r != '.' {
+			return false, errors.New("unknown rune")
+		}
+		return true, nil
+	})
+	if !errors.As(err, &parseErr) || parseErr.Line != 2 || parseErr.Column != 2 {
+		t.Errorf("got error %v, expected a ParseError on line 2 column 2", err)
+	}
+}
+
+func TestTransformations(t *testing.T) {
+	grid, err := FromRows([][]rune{[]rune("abc"), []rune("def")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name     string
+		result   *Grid[rune]
+		expected string
+	}{
+		{"Transpose", grid.Transpose(), "ad\nbe\ncf"},
+		{"RotateClockwise", grid.RotateClockwise(), "da\neb\nfc"},
+		{"RotateCounterClockwise", grid.RotateCounterClockwise(), "cf\nbe\nad"},
+	}
+	for _, testCase := range testCases {
+		if formatted := testCase.result.Format(formatRune); formatted != testCase.expected {
+			t.Errorf("%v: got %q, expected %q", testCase.name, formatted, testCase.expected)
+		}
+	}
+
+	if column := string(grid.Column(1)); column != "be" {
+		t.Errorf("got column %q, expected \"be\"", column)
+	}
+	if _, err := FromRows([][]rune{[]rune("ab"), []rune("c")}); err == nil {
+		t.Errorf("expected error for ragged rows")
+	}
+}
+
+func TestNeighborsAndWrapping(t *testing.T) {
+	grid := New[int](3, 3)
+	if neighbors := grid.Neighbors(Point{0, 0}, CARDINAL_DIRECTIONS); len(neighbors) != 2 {
+		t.Errorf("got corner neighbors %v, expected 2", neighbors)
+	}
+	if neighbors := grid.Neighbors(Point{1, 1}, ALL_DIRECTIONS); len(neighbors) != 8 {
+		t.Errorf("got center neighbors %v, expected 8", neighbors)
+	}
+
+	grid.Fill(1)
+	if value := grid.Get(Point{2, 2}); value != 1 {
+		t.Errorf("got value %v after fill, expected 1", value)
+	}
+	grid.Set(Point{2, 0}, 7)
+	if wrapped := grid.Wrap(Point{-1, -3}); wrapped != (Point{2, 0}) {
+		t.Errorf("got wrapped point %v, expected (2, 0)", wrapped)
+	}
+	if value := grid.GetWrapped(Point{5, 6}); value != 7 {
+		t.Errorf("got wrapped value %v, expected 7", value)
+	}
+	if edge := grid.Edge(DIRECTION_RIGHT); len(edge) != 3 || edge[0] != (Point{2, 0}) {
+		t.Errorf("got right edge %v", edge)
+	}
+	if points := grid.Find(func(value int) bool { return value == 7 }); len(points) != 1 || points[0] != (Point{2, 0}) {
+		t.Errorf("got found points %v, expected [(2, 0)]", points)
+	}
+}
+
+func TestDirections(t *testing.T) {
+	for _, direction := range ALL_DIRECTIONS {
+		if direction.TurnClockwise().TurnCounterClockwise() != direction {
+			t.Errorf("%v: turning clockwise then counterclockwise did not return to the same direction", direction)
+		}
+		if direction.Opposite().Opposite() != direction {
+			t.Errorf("%v: opposite of opposite is not the same direction", direction)
+		}
+		if offset, opposite := direction.Offset(), direction.Opposite().Offset(); offset.Add(opposite) != (Point{}) {
+			t.Errorf("%v: offset %v does not cancel the opposite offset %v", direction, offset, opposite)
+		}
+	}
+	if DIRECTION_LEFT.TurnClockwise() != DIRECTION_UP || DIRECTION_UP_LEFT.TurnClockwise() != DIRECTION_UP_RIGHT {
+		t.Errorf("clockwise turns do not wrap around")
+	}
+	if moved := (Point{1, 1}).MoveN(DIRECTION_DOWN_LEFT, 2); moved != (Point{-1, 3}) {
+		t.Errorf("got %v, expected (-1, 3)", moved)
+	}
+}
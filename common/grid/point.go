@@ -0,0 +1,45 @@
+package grid
+
+import "fmt"
+
+// A position in a grid, with x increasing to the right and y increasing downwards
+type Point struct {
+	X int
+	Y int
+}
+
+func (point Point) String() string {
+	return fmt.Sprintf("(%v, %v)", point.X, point.Y)
+}
+
+func (point Point) Add(other Point) Point {
+	return Point{
+		X: point.X + other.X,
+		Y: point.Y + other.Y,
+	}
+}
+
+// The point one step away in the given direction
+func (point Point) Move(direction DirectionEnum) Point {
+	return point.Add(direction.Offset())
+}
+
+// The point the given number of steps away in the given direction
+func (point Point) MoveN(direction DirectionEnum, steps int) Point {
+	offset := direction.Offset()
+	return Point{
+		X: point.X + steps*offset.X,
+		Y: point.Y + steps*offset.Y,
+	}
+}
+
+func (point Point) ManhattanDistance(other Point) int {
+	return abs(point.X-other.X) + abs(point.Y-other.Y)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,190 @@
+// Iterators over the lines, blank line separated blocks and separated tokens of puzzle input,
+// with no limit on the length of a line or token.
+package input
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"math"
+	"strings"
+)
+
+// The initial buffer size of scanners, grown as needed for longer lines
+const INITIAL_BUFFER_SIZE = 64 * 1024
+
+// Create a scanner over the lines of r with no limit on the length of a line,
+// unlike bufio.NewScanner which fails on lines over 64 KiB
+func NewScanner(r io.Reader) *bufio.Scanner {
+	fileScanner := bufio.NewScanner(r)
+	fileScanner.Buffer(make([]byte, 0, INITIAL_BUFFER_SIZE), math.MaxInt)
+	return fileScanner
+}
+
+// Read the remaining lines of a scanner, each followed by a newline
+//
+// This lets a ProcessInput given a scanner use the iterators of this package
+func FromScanner(fileScanner *bufio.Scanner) io.Reader {
+	return &scannerReader{fileScanner: fileScanner}
+}
+
+type scannerReader struct {
+	fileScanner *bufio.Scanner
+	line        []byte
+	pending     []byte
+}
+
+func (reader *scannerReader) Read(p []byte) (int, error) {
+	for len(reader.pending) == 0 {
+		if !reader.fileScanner.Scan() {
+			if err := reader.fileScanner.Err(); err != nil {
+				return 0, err
+			}
+			return 0, io.EOF
+		}
+		// Copy the line, as appending to the bytes of the scanner could overwrite its buffer
+		reader.line = append(reader.line[:0], reader.fileScanner.Bytes()...)
+		reader.line = append(reader.line, '\n')
+		reader.pending = reader.line
+	}
+	n := copy(p, reader.pending)
+	reader.pending = reader.pending[n:]
+	return n, nil
+}
+
+// Iterates over values read from an input, in the manner of bufio.Scanner
+//
+//	lines := input.Lines(r)
+//	for lines.Next() {
+//		line := lines.Value()
+//	}
+//	if err := lines.Err(); err != nil {
+//		...
+//	}
+type Iterator[T any] struct {
+	// Get the next value, or io.EOF once there are no more values
+	next  func() (T, error)
+	value T
+	err   error
+}
+
+// Advance to the next value, returning false at the end of the input or on an error
+func (iterator *Iterator[T]) Next() bool {
+	if iterator.err != nil {
+		return false
+	}
+	iterator.value, iterator.err = iterator.next()
+	return iterator.err == nil
+}
+
+// The current value, valid after a call to Next returns true
+func (iterator *Iterator[T]) Value() T {
+	return iterator.value
+}
+
+// The error that stopped the iterator, or nil if it reached the end of the input
+func (iterator *Iterator[T]) Err() error {
+	if errors.Is(iterator.err, io.EOF) {
+		return nil
+	}
+	return iterator.err
+}
+
+// Read every remaining value of the iterator
+func (iterator *Iterator[T]) Collect() ([]T, error) {
+	values := make([]T, 0)
+	for iterator.Next() {
+		values = append(values, iterator.Value())
+	}
+	return values, iterator.Err()
+}
+
+// Read up to and including the next delimiter, returning io.EOF only once no text remains
+func readUntil(reader *bufio.Reader, delimiter byte) (string, error) {
+	text, err := reader.ReadString(delimiter)
+	if errors.Is(err, io.EOF) && len(text) > 0 {
+		return text, nil
+	}
+	return text, err
+}
+
+// Iterate over the lines of r, without their line endings
+func Lines(r io.Reader) *Iterator[string] {
+	reader := bufio.NewReader(r)
+	return &Iterator[string]{
+		next: func() (string, error) {
+			line, err := readUntil(reader, '\n')
+			if err != nil {
+				return "", err
+			}
+			line = strings.TrimSuffix(line, "\n")
+			return strings.TrimSuffix(line, "\r"), nil
+		},
+	}
+}
+
+// A run of non-blank lines of the input
+type Block struct {
+	Lines []string
+	// The line number of the first line of the block, starting from 1
+	FirstLineNumber int
+}
+
+// Iterate over the blocks of lines of r separated by one or more blank lines
+//
+// The last block need not be followed by a blank line
+func Blocks(r io.Reader) *Iterator[Block] {
+	lines := Lines(r)
+	lineNumber := 0
+	return &Iterator[Block]{
+		next: func() (Block, error) {
+			block := Block{
+				Lines: make([]string, 0),
+			}
+			for lines.Next() {
+				lineNumber += 1
+				line := lines.Value()
+				if len(line) == 0 {
+					if len(block.Lines) > 0 {
+						return block, nil
+					}
+					continue
+				}
+				if len(block.Lines) == 0 {
+					block.FirstLineNumber = lineNumber
+				}
+				block.Lines = append(block.Lines, line)
+			}
+			if err := lines.Err(); err != nil {
+				return Block{}, err
+			}
+			if len(block.Lines) == 0 {
+				return Block{}, io.EOF
+			}
+			return block, nil
+		},
+	}
+}
+
+// Iterate over the tokens of r separated by sep, ignoring line endings
+//
+// A trailing empty token, such as after a final separator, is skipped
+func Tokens(r io.Reader, sep byte) *Iterator[string] {
+	reader := bufio.NewReader(r)
+	removeLineEndings := strings.NewReplacer("\n", "", "\r", "")
+	return &Iterator[string]{
+		next: func() (string, error) {
+			token, err := readUntil(reader, sep)
+			if err != nil {
+				return "", err
+			}
+			token = removeLineEndings.Replace(strings.TrimSuffix(token, string(sep)))
+			if len(token) == 0 {
+				if _, err := reader.Peek(1); errors.Is(err, io.EOF) {
+					return "", io.EOF
+				}
+			}
+			return token, nil
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package input
+
+import (
+	"bufio"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	testCases := map[string][]string{
+		"":             {},
+		"a":            {"a"},
+		"a\n":          {"a"},
+		"a\r\n\nb":     {"a", "", "b"},
+		"a\n\n\nb\n\n": {"a", "", "", "b", ""},
+	}
+	for text, expected := range testCases {
+		lines, err := Lines(strings.NewReader(text)).Collect()
+		if err != nil || !reflect.DeepEqual(lines, expected) {
+			t.Errorf("got lines %q (error %v) of %q, expected %q", lines, err, text, expected)
+		}
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	text := "\nseeds: 1 2\n\nmap:\n1 2 3\n4 5 6\n\n\nlast:\n7 8 9"
+	blocks, err := Blocks(strings.NewReader(text)).Collect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Block{
+		{Lines: []string{"seeds: 1 2"}, FirstLineNumber: 2},
+		{Lines: []string{"map:", "1 2 3", "4 5 6"}, FirstLineNumber: 4},
+		{Lines: []string{"last:", "7 8 9"}, FirstLineNumber: 9},
+	}
+	if !reflect.DeepEqual(blocks, expected) {
+		t.Errorf("got blocks %v, expected %v", blocks, expected)
+	}
+
+	blocks, err = Blocks(strings.NewReader("a\nb\n\n")).Collect()
+	if err != nil || len(blocks) != 1 {
+		t.Errorf("got blocks %v (error %v), expected a single block", blocks, err)
+	}
+}
+
+func TestTokens(t *testing.T) {
+	testCases := map[string][]string{
+		"rn=1,cm-,qp=3\n": {"rn=1", "cm-", "qp=3"},
+		"rn=1,c\nm-,":     {"rn=1", "cm-"},
+		"a,,b":            {"a", "", "b"},
+		"\n":              {},
+	}
+	for text, expected := range testCases {
+		tokens, err := Tokens(strings.NewReader(text), ',').Collect()
+		if err != nil || !reflect.DeepEqual(tokens, expected) {
+			t.Errorf("got tokens %q (error %v) of %q, expected %q", tokens, err, text, expected)
+		}
+	}
+}
+
+func TestLongLines(t *testing.T) {
+	longToken := strings.Repeat("ab=1", 100000)
+	text := strings.Repeat(longToken+",", 3) + "\n"
+
+	if _, err := Tokens(FromScanner(bufio.NewScanner(strings.NewReader(text))), ',').Collect(); err != bufio.ErrTooLong {
+		t.Errorf("expected bufio.NewScanner to fail on a long line, got %v", err)
+	}
+
+	tokens, err := Tokens(FromScanner(NewScanner(strings.NewReader(text))), ',').Collect()
+	if err != nil || len(tokens) != 3 || tokens[2] != longToken {
+		t.Errorf("got %v tokens (error %v), expected 3 long tokens", len(tokens), err)
+	}
+}
+
+func TestFromScanner(t *testing.T) {
+	fileScanner := NewScanner(strings.NewReader("first\nsecond\nthird"))
+	fileScanner.Scan()
+	remaining, err := io.ReadAll(FromScanner(fileScanner))
+	if err != nil || string(remaining) != "second\nthird\n" {
+		t.Errorf("got %q (error %v), expected the remaining lines", remaining, err)
+	}
+}
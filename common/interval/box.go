@@ -0,0 +1,72 @@
+package interval
+
+import (
+	"fmt"
+	"strings"
+)
+
+// An N-dimensional box of integer points, spanning an interval on each axis
+type Box []Interval
+
+func NewBox(axes ...Interval) Box {
+	return Box(axes)
+}
+
+// The number of points in the box, or 0 if the interval of any axis is empty
+func (box Box) Volume() int {
+	volume := 1
+	for _, axis := range box {
+		volume *= axis.Len()
+	}
+	return volume
+}
+
+func (box Box) IsEmpty() bool {
+	return box.Volume() == 0
+}
+
+// Check if a point, with a coordinate for each axis, is inside the box
+func (box Box) Contains(point []int) bool {
+	if len(point) != len(box) {
+		return false
+	}
+	for i, axis := range box {
+		if !axis.Contains(point[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// The points in both boxes, which must have the same number of axes
+func (box Box) Intersect(other Box) Box {
+	if len(box) != len(other) {
+		panic(fmt.Sprintf("interval: intersecting boxes of %v and %v axes", len(box), len(other)))
+	}
+	intersection := make(Box, len(box))
+	for i := range box {
+		intersection[i] = box[i].Intersect(other[i])
+	}
+	return intersection
+}
+
+// Split the box on the plane where the coordinate of an axis is value, into the points
+// below the plane and those on or above it, either of which may be empty
+func (box Box) SplitAt(axis int, value int) (Box, Box) {
+	below := box.Clone()
+	above := box.Clone()
+	below[axis], above[axis] = box[axis].SplitAt(value)
+	return below, above
+}
+
+func (box Box) Clone() Box {
+	return append(Box{}, box...)
+}
+
+func (box Box) String() string {
+	parts := make([]string, len(box))
+	for i, axis := range box {
+		parts[i] = axis.String()
+	}
+	return strings.Join(parts, " x ")
+}
@@ -0,0 +1,67 @@
+// Half-open integer intervals, sets of them and the boxes they span, for puzzles that
+// push whole ranges of values through a process rather than one value at a time.
+package interval
+
+import "fmt"
+
+// The integers from Start up to but not including End. Empty if End is not after Start
+type Interval struct {
+	Start int
+	End   int
+}
+
+func New(start int, end int) Interval {
+	return Interval{Start: start, End: end}
+}
+
+// The interval of length integers starting at start
+func FromLength(start int, length int) Interval {
+	return Interval{Start: start, End: start + length}
+}
+
+func (interval Interval) IsEmpty() bool {
+	return interval.End <= interval.Start
+}
+
+// The number of integers in the interval, which is never negative
+func (interval Interval) Len() int {
+	if interval.IsEmpty() {
+		return 0
+	}
+	return interval.End - interval.Start
+}
+
+func (interval Interval) Contains(value int) bool {
+	return interval.Start <= value && value < interval.End
+}
+
+// The integers in both intervals, which may be empty
+func (interval Interval) Intersect(other Interval) Interval {
+	return Interval{
+		Start: max(interval.Start, other.Start),
+		End:   min(interval.End, other.End),
+	}
+}
+
+func (interval Interval) Overlaps(other Interval) bool {
+	return !interval.Intersect(other).IsEmpty()
+}
+
+// The interval moved by an offset
+func (interval Interval) Shift(offset int) Interval {
+	return Interval{
+		Start: interval.Start + offset,
+		End:   interval.End + offset,
+	}
+}
+
+// Split the interval into the integers below the value and those at or above it, either of which may be empty
+func (interval Interval) SplitAt(value int) (Interval, Interval) {
+	below := Interval{interval.Start, min(interval.End, value)}
+	above := Interval{max(interval.Start, value), interval.End}
+	return below, above
+}
+
+func (interval Interval) String() string {
+	return fmt.Sprintf("[%v, %v)", interval.Start, interval.End)
+}
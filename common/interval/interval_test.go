@@ -0,0 +1,84 @@
+package interval
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestInterval(t *testing.T) {
+	interval := New(3, 8)
+	if interval.Len() != 5 || !interval.Contains(3) || interval.Contains(8) {
+		t.Errorf("got length %v for %v, expected 5 containing 3 but not 8", interval.Len(), interval)
+	}
+	if empty := New(5, 2); !empty.IsEmpty() || empty.Len() != 0 {
+		t.Errorf("expected %v to be empty", empty)
+	}
+	if intersection := interval.Intersect(New(6, 20)); intersection != New(6, 8) {
+		t.Errorf("got intersection %v, expected [6, 8)", intersection)
+	}
+	if interval.Overlaps(New(8, 10)) {
+		t.Errorf("expected %v not to overlap [8, 10)", interval)
+	}
+
+	below, above := interval.SplitAt(5)
+	if below != New(3, 5) || above != New(5, 8) {
+		t.Errorf("got split %v and %v, expected [3, 5) and [5, 8)", below, above)
+	}
+	if below, above := interval.SplitAt(10); below != interval || !above.IsEmpty() {
+		t.Errorf("got split %v and %v beyond the end, expected the whole interval below", below, above)
+	}
+}
+
+func TestIntervalSet(t *testing.T) {
+	set := NewIntervalSet(New(10, 15), New(0, 3), New(3, 5), New(12, 20), New(7, 7))
+	if !slices.Equal(set.Intervals(), []Interval{{0, 5}, {10, 20}}) {
+		t.Fatalf("got normalised intervals %v, expected [0, 5) and [10, 20)", set)
+	}
+	if set.Len() != 15 || !set.Contains(10) || set.Contains(5) || set.Contains(-1) {
+		t.Errorf("got length %v for %v, or unexpected membership", set.Len(), set)
+	}
+
+	other := NewIntervalSet(New(4, 11), New(14, 16), New(19, math.MaxInt))
+	tests := []struct {
+		name     string
+		result   IntervalSet
+		expected []Interval
+	}{
+		{"Union", set.Union(other), []Interval{{0, math.MaxInt}}},
+		{"Intersection", set.Intersection(other), []Interval{{4, 5}, {10, 11}, {14, 16}, {19, 20}}},
+		{"Difference", set.Difference(other), []Interval{{0, 4}, {11, 14}, {16, 19}}},
+		{"ReverseDifference", other.Difference(set), []Interval{{5, 10}, {20, math.MaxInt}}},
+	}
+	for _, test := range tests {
+		if !slices.Equal(test.result.Intervals(), test.expected) {
+			t.Errorf("%v: got %v, expected %v", test.name, test.result, test.expected)
+		}
+	}
+}
+
+func TestBox(t *testing.T) {
+	box := NewBox(New(1, 4001), New(1, 4001), New(0, 10))
+	if volume := box.Volume(); volume != 4000*4000*10 {
+		t.Errorf("got volume %v", volume)
+	}
+
+	below, above := box.SplitAt(1, 1001)
+	if below.Volume() != 1000*4000*10 || above.Volume() != 3000*4000*10 {
+		t.Errorf("got split volumes %v and %v", below.Volume(), above.Volume())
+	}
+	if box[1] != New(1, 4001) {
+		t.Errorf("splitting changed the original box to %v", box)
+	}
+	if !below.Contains([]int{5, 1000, 0}) || below.Contains([]int{5, 1001, 0}) {
+		t.Errorf("unexpected membership of %v", below)
+	}
+
+	intersection := box.Intersect(NewBox(New(0, 2), New(3, 5), New(9, 20)))
+	if intersection.Volume() != 2 {
+		t.Errorf("got intersection %v, expected volume 2", intersection)
+	}
+	if disjoint := below.Intersect(above); !disjoint.IsEmpty() {
+		t.Errorf("expected the halves of a split to be disjoint, got %v", disjoint)
+	}
+}
@@ -0,0 +1,125 @@
+package interval
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// A set of integers held as intervals.
+//
+// The intervals are kept normalised, so they are sorted, not empty, and neither overlap nor touch.
+type IntervalSet struct {
+	intervals []Interval
+}
+
+func NewIntervalSet(intervals ...Interval) IntervalSet {
+	return IntervalSet{Normalise(intervals)}
+}
+
+// Sort the intervals and merge any that overlap or touch, dropping those that are empty
+func Normalise(intervals []Interval) []Interval {
+	sorted := make([]Interval, 0, len(intervals))
+	for _, interval := range intervals {
+		if !interval.IsEmpty() {
+			sorted = append(sorted, interval)
+		}
+	}
+	slices.SortFunc(sorted, func(a, b Interval) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	normalised := make([]Interval, 0, len(sorted))
+	for _, interval := range sorted {
+		last := len(normalised) - 1
+		if last >= 0 && interval.Start <= normalised[last].End {
+			normalised[last].End = max(normalised[last].End, interval.End)
+			continue
+		}
+		normalised = append(normalised, interval)
+	}
+	return normalised
+}
+
+// The normalised intervals of the set, which must not be modified
+func (set IntervalSet) Intervals() []Interval {
+	return set.intervals
+}
+
+// The number of integers in the set
+func (set IntervalSet) Len() int {
+	total := 0
+	for _, interval := range set.intervals {
+		total += interval.Len()
+	}
+	return total
+}
+
+func (set IntervalSet) IsEmpty() bool {
+	return len(set.intervals) == 0
+}
+
+func (set IntervalSet) Contains(value int) bool {
+	// Find the last interval starting at or before the value
+	index, found := slices.BinarySearchFunc(set.intervals, value, func(interval Interval, value int) int {
+		return cmp.Compare(interval.Start, value)
+	})
+	if found {
+		return true
+	}
+	return index > 0 && set.intervals[index-1].Contains(value)
+}
+
+func (set IntervalSet) Union(other IntervalSet) IntervalSet {
+	return NewIntervalSet(append(slices.Clone(set.intervals), other.intervals...)...)
+}
+
+func (set IntervalSet) Intersection(other IntervalSet) IntervalSet {
+	intersection := make([]Interval, 0)
+	// Both sets are sorted, so walk them together, moving past whichever interval ends first
+	i, j := 0, 0
+	for i < len(set.intervals) && j < len(other.intervals) {
+		overlap := set.intervals[i].Intersect(other.intervals[j])
+		if !overlap.IsEmpty() {
+			intersection = append(intersection, overlap)
+		}
+		if set.intervals[i].End < other.intervals[j].End {
+			i += 1
+		} else {
+			j += 1
+		}
+	}
+	return IntervalSet{intersection}
+}
+
+// The integers in the set but not in the other
+func (set IntervalSet) Difference(other IntervalSet) IntervalSet {
+	difference := make([]Interval, 0)
+	j := 0
+	for _, interval := range set.intervals {
+		remaining := interval
+		// Skip intervals of the other set ending before this one starts
+		for j < len(other.intervals) && other.intervals[j].End <= remaining.Start {
+			j += 1
+		}
+		for k := j; k < len(other.intervals) && other.intervals[k].Start < remaining.End; k += 1 {
+			below, _ := remaining.SplitAt(other.intervals[k].Start)
+			if !below.IsEmpty() {
+				difference = append(difference, below)
+			}
+			_, remaining = remaining.SplitAt(other.intervals[k].End)
+		}
+		if !remaining.IsEmpty() {
+			difference = append(difference, remaining)
+		}
+	}
+	return IntervalSet{difference}
+}
+
+func (set IntervalSet) String() string {
+	parts := make([]string, len(set.intervals))
+	for i, interval := range set.intervals {
+		parts[i] = interval.String()
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
@@ -0,0 +1,230 @@
+// Configuration of the global zerolog logger from command line flags, shared by the commands
+// of the runner.
+package logging
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	FORMAT_CONSOLE = "console"
+	FORMAT_JSON    = "json"
+
+	// The file written by -logToFile
+	DEFAULT_LOG_FILE_PATH = "log"
+
+	// Matches the packages below a path as well as the path itself, as in go tooling
+	SUBPACKAGE_WILDCARD = "/..."
+)
+
+// The logging flags of a command
+type Config struct {
+	Level     string
+	File      string
+	Format    string
+	LogToFile bool
+}
+
+// Add the -logLevel, -logFile, -logFormat and -logToFile flags to the flag set
+func RegisterFlags(flags *flag.FlagSet, defaultLevel zerolog.Level) *Config {
+	config := &Config{}
+	flags.StringVar(&config.Level, "logLevel", defaultLevel.String(), "Level to log at (trace, debug, info, warn, error, disabled), optionally followed by comma separated overrides for packages, e.g. info,hmcalister/aoc19/part02/lib=trace or info,hmcalister/aoc16/...=debug")
+	flags.StringVar(&config.File, "logFile", "", "Path of a file to log to, rather than to console output")
+	flags.StringVar(&config.Format, "logFormat", "", "Format of log output: console or json. Defaults to console, or json when logging to a file")
+	flags.BoolVar(&config.LogToFile, "logToFile", false, "Flag to log to the file \"log\", the same as -logFile log")
+	return config
+}
+
+// Point the global logger at the console writer given, or at the log file if one is set,
+// with the levels and format of the config
+func (config *Config) Configure(console io.Writer) error {
+	levels, err := ParseLevels(config.Level)
+	if err != nil {
+		return err
+	}
+
+	output := console
+	logFilePath := config.File
+	if logFilePath == "" && config.LogToFile {
+		logFilePath = DEFAULT_LOG_FILE_PATH
+	}
+	if logFilePath != "" {
+		logFile, err := os.Create(logFilePath)
+		if err != nil {
+			return fmt.Errorf("could not open log file: %w", err)
+		}
+		output = logFile
+	}
+
+	format := config.Format
+	if format == "" {
+		format = FORMAT_CONSOLE
+		if logFilePath != "" {
+			format = FORMAT_JSON
+		}
+	}
+	switch format {
+	case FORMAT_CONSOLE:
+		output = zerolog.ConsoleWriter{Out: output, NoColor: logFilePath != ""}
+	case FORMAT_JSON:
+	default:
+		return fmt.Errorf("unknown log format %q, expected %v or %v", format, FORMAT_CONSOLE, FORMAT_JSON)
+	}
+
+	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
+	zerolog.SetGlobalLevel(levels.MinLevel())
+	logger := zerolog.New(output).With().Timestamp().Logger()
+	if len(levels.Packages) > 0 {
+		logger = logger.Hook(levels)
+	}
+	log.Logger = logger
+	return nil
+}
+
+// The level to log at for the packages matching a pattern
+type PackageLevel struct {
+	Pattern string
+	Level   zerolog.Level
+}
+
+// A default level, with overrides for some packages
+//
+// The most specific (longest) matching pattern gives the level of a package
+type Levels struct {
+	Default  zerolog.Level
+	Packages []PackageLevel
+}
+
+// Parse a level specification such as "info,hmcalister/aoc19/part02/lib=trace"
+//
+// The first entry may be a level on its own, which is the default for all other packages.
+// Otherwise the default is info
+func ParseLevels(spec string) (Levels, error) {
+	levels := Levels{
+		Default:  zerolog.InfoLevel,
+		Packages: make([]PackageLevel, 0),
+	}
+
+	if strings.TrimSpace(spec) == "" {
+		return levels, nil
+	}
+
+	for entryIndex, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		pattern, levelStr, isOverride := strings.Cut(entry, "=")
+		if !isOverride {
+			if entryIndex != 0 {
+				return Levels{}, fmt.Errorf("log level %q must come first, or be given as package=level", entry)
+			}
+			levelStr = entry
+		}
+
+		levelStr = strings.ToLower(strings.TrimSpace(levelStr))
+		level, err := zerolog.ParseLevel(levelStr)
+		if err != nil || levelStr == "" {
+			return Levels{}, fmt.Errorf("invalid log level %q in %q", levelStr, entry)
+		}
+
+		if !isOverride {
+			levels.Default = level
+			continue
+		}
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			return Levels{}, fmt.Errorf("missing package in %q", entry)
+		}
+		levels.Packages = append(levels.Packages, PackageLevel{
+			Pattern: pattern,
+			Level:   level,
+		})
+	}
+
+	sort.SliceStable(levels.Packages, func(i, j int) bool {
+		return len(levels.Packages[i].Pattern) > len(levels.Packages[j].Pattern)
+	})
+	return levels, nil
+}
+
+// The lowest level of any package, which the global level must allow for overrides to be logged
+func (levels Levels) MinLevel() zerolog.Level {
+	minLevel := levels.Default
+	for _, packageLevel := range levels.Packages {
+		minLevel = min(minLevel, packageLevel.Level)
+	}
+	return minLevel
+}
+
+// The highest level of any package, at or above which every package logs
+func (levels Levels) MaxLevel() zerolog.Level {
+	maxLevel := levels.Default
+	for _, packageLevel := range levels.Packages {
+		maxLevel = max(maxLevel, packageLevel.Level)
+	}
+	return maxLevel
+}
+
+// The level to log at for the package with the given import path
+func (levels Levels) LevelOf(packagePath string) zerolog.Level {
+	for _, packageLevel := range levels.Packages {
+		if matchesPackage(packageLevel.Pattern, packagePath) {
+			return packageLevel.Level
+		}
+	}
+	return levels.Default
+}
+
+func matchesPackage(pattern string, packagePath string) bool {
+	if basePath, found := strings.CutSuffix(pattern, SUBPACKAGE_WILDCARD); found {
+		return packagePath == basePath || strings.HasPrefix(packagePath, basePath+"/")
+	}
+	return packagePath == pattern
+}
+
+// Discard events logged below the level of the package that logged them
+//
+// The global level already drops events below every package level, and events at or above every
+// package level are always kept, so only the levels in between need the caller found from the stack
+func (levels Levels) Run(e *zerolog.Event, level zerolog.Level, msg string) {
+	if level >= levels.MaxLevel() {
+		return
+	}
+	if level < levels.LevelOf(callerPackage()) {
+		e.Discard()
+	}
+}
+
+// Find the package of the first caller outside of zerolog and this package
+func callerPackage() string {
+	pcs := make([]uintptr, 16)
+	numCallers := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:numCallers])
+	for {
+		frame, more := frames.Next()
+		packagePath := functionPackage(frame.Function)
+		if !strings.HasPrefix(packagePath, "github.com/rs/zerolog") && packagePath != "hmcalister/aocCommon/logging" {
+			return packagePath
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// Get the import path of the package of a function name such as hmcalister/aoc19/part02/lib.(*WorkflowData).Apply
+func functionPackage(function string) string {
+	lastSlashIndex := strings.LastIndex(function, "/")
+	dotIndex := strings.Index(function[lastSlashIndex+1:], ".")
+	if dotIndex == -1 {
+		return function
+	}
+	return function[:lastSlashIndex+1+dotIndex]
+}
@@ -0,0 +1,130 @@
+package logging_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"hmcalister/aocCommon/logging"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+func TestParseLevels(t *testing.T) {
+	levels, err := logging.ParseLevels("warn, hmcalister/aoc19/lib=trace,hmcalister/aoc16/...=debug,hmcalister/aoc16/part02=error")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if levels.MinLevel() != zerolog.TraceLevel {
+		t.Errorf("got minimum level %v, expected trace", levels.MinLevel())
+	}
+	if levels.MaxLevel() != zerolog.ErrorLevel {
+		t.Errorf("got maximum level %v, expected error", levels.MaxLevel())
+	}
+
+	testCases := map[string]zerolog.Level{
+		"hmcalister/aoc19/lib":    zerolog.TraceLevel,
+		"hmcalister/aoc19/part01": zerolog.WarnLevel,
+		"hmcalister/aoc16":        zerolog.DebugLevel,
+		"hmcalister/aoc16/lib":    zerolog.DebugLevel,
+		"hmcalister/aoc16/part02": zerolog.ErrorLevel,
+		"hmcalister/aoc161/lib":   zerolog.WarnLevel,
+	}
+	for packagePath, expected := range testCases {
+		if level := levels.LevelOf(packagePath); level != expected {
+			t.Errorf("got level %v for %v, expected %v", level, packagePath, expected)
+		}
+	}
+
+	for _, spec := range []string{"loud", "info,debug", "info,=debug", "info,hmcalister/aoc19/lib=", "info,hmcalister/aoc19/lib=loud"} {
+		if _, err := logging.ParseLevels(spec); err == nil {
+			t.Errorf("expected error parsing %q", spec)
+		}
+	}
+
+	levels, err = logging.ParseLevels("")
+	if err != nil || levels.Default != zerolog.InfoLevel {
+		t.Errorf("got default level %v (error %v) for an empty spec, expected info", levels.Default, err)
+	}
+}
+
+func logMessages(t *testing.T, args []string) []string {
+	t.Helper()
+	defer func(logger zerolog.Logger, level zerolog.Level) {
+		log.Logger = logger
+		zerolog.SetGlobalLevel(level)
+	}(log.Logger, zerolog.GlobalLevel())
+
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	config := logging.RegisterFlags(flags, zerolog.InfoLevel)
+	if err := flags.Parse(args); err != nil {
+		t.Fatal(err)
+	}
+
+	var console bytes.Buffer
+	if err := config.Configure(&console); err != nil {
+		t.Fatal(err)
+	}
+	log.Trace().Msg("trace")
+	log.Debug().Msg("debug")
+	log.Info().Msg("info")
+
+	messages := make([]string, 0)
+	for _, line := range strings.Split(strings.TrimSpace(console.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var event map[string]any
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			t.Fatalf("expected json log line, got %q", line)
+		}
+		messages = append(messages, event["message"].(string))
+	}
+	return messages
+}
+
+func TestConfigure(t *testing.T) {
+	testCases := []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"-logFormat", "json"}, "info"},
+		{[]string{"-logFormat", "json", "-logLevel", "debug"}, "debug,info"},
+		{[]string{"-logFormat", "json", "-logLevel", "error,hmcalister/aocCommon/logging_test=trace"}, "trace,debug,info"},
+		{[]string{"-logFormat", "json", "-logLevel", "trace,hmcalister/aocCommon/...=info"}, "info"},
+		{[]string{"-logFormat", "json", "-logLevel", "info,hmcalister/aoc19/lib=trace"}, "info"},
+	}
+	for _, testCase := range testCases {
+		messages := strings.Join(logMessages(t, testCase.args), ",")
+		if messages != testCase.expected {
+			t.Errorf("args %v: got messages %v, expected %v", testCase.args, messages, testCase.expected)
+		}
+	}
+}
+
+func TestLogFile(t *testing.T) {
+	logFilePath := filepath.Join(t.TempDir(), "out.log")
+	messages := logMessages(t, []string{"-logFile", logFilePath})
+	if len(messages) != 0 {
+		t.Errorf("got messages %v on the console, expected none", messages)
+	}
+
+	data, err := os.ReadFile(logFilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"message":"info"`) {
+		t.Errorf("expected json info message in log file, got %q", data)
+	}
+
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	config := logging.RegisterFlags(flags, zerolog.InfoLevel)
+	flags.Parse([]string{"-logFormat", "xml"})
+	if err := config.Configure(&bytes.Buffer{}); err == nil {
+		t.Errorf("expected error for an unknown log format")
+	}
+}
@@ -0,0 +1,112 @@
+// Errors and helpers for parsing puzzle input, so malformed input is reported
+// with its exact location rather than crashing or silently giving a wrong answer.
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// An error in the puzzle input of a day, locating the offending text.
+// Failures of a solver after the input is parsed, such as finding no answer, are plain errors instead
+type ParseError struct {
+	Day int
+	// Line number of the offending text, starting from 1. Zero if unknown.
+	Line int
+	// Column of the start of the offending text within its line, starting from 1. Zero if unknown.
+	Column int
+	Text   string
+	Err    error
+}
+
+func NewError(day int, line int, column int, text string, err error) *ParseError {
+	return &ParseError{
+		Day:    day,
+		Line:   line,
+		Column: column,
+		Text:   text,
+		Err:    err,
+	}
+}
+
+// Create a ParseError with a message formatted as in fmt.Errorf
+func Errorf(day int, line int, column int, text string, format string, args ...any) *ParseError {
+	return NewError(day, line, column, text, fmt.Errorf(format, args...))
+}
+
+// Unknown lines and columns are left out, so errors about the input as a whole read naturally
+func (err *ParseError) Error() string {
+	if err.Line == 0 {
+		return fmt.Sprintf("day %02d: %v", err.Day, err.Err)
+	}
+	if err.Column == 0 {
+		return fmt.Sprintf("day %02d: line %v: %v (text %q)", err.Day, err.Line, err.Err, err.Text)
+	}
+	return fmt.Sprintf("day %02d: line %v, column %v: %v (text %q)", err.Day, err.Line, err.Column, err.Err, err.Text)
+}
+
+func (err *ParseError) Unwrap() error {
+	return err.Err
+}
+
+// Some text from a line of input, along with the column it starts at
+type Field struct {
+	Text   string
+	Column int
+}
+
+// Split s around runs of whitespace, as in strings.Fields, keeping the column of each field.
+//
+// startColumn is the column of the start of s within its line, starting from 1.
+func Fields(s string, startColumn int) []Field {
+	fields := make([]Field, 0)
+	fieldStart := -1
+	for i, r := range s {
+		if unicode.IsSpace(r) {
+			if fieldStart != -1 {
+				fields = append(fields, Field{s[fieldStart:i], startColumn + fieldStart})
+				fieldStart = -1
+			}
+		} else if fieldStart == -1 {
+			fieldStart = i
+		}
+	}
+	if fieldStart != -1 {
+		fields = append(fields, Field{s[fieldStart:], startColumn + fieldStart})
+	}
+	return fields
+}
+
+// Split s around each instance of sep, as in strings.Split, keeping the column of each field.
+//
+// startColumn is the column of the start of s within its line, starting from 1.
+func Split(s string, sep string, startColumn int) []Field {
+	parts := strings.Split(s, sep)
+	fields := make([]Field, len(parts))
+	offset := 0
+	for i, part := range parts {
+		fields[i] = Field{part, startColumn + offset}
+		offset += len(part) + len(sep)
+	}
+	return fields
+}
+
+// Remove leading and trailing whitespace from the field, adjusting its column
+func (field Field) TrimSpace() Field {
+	trimmedLeft := strings.TrimLeftFunc(field.Text, unicode.IsSpace)
+	return Field{
+		Text:   strings.TrimRightFunc(trimmedLeft, unicode.IsSpace),
+		Column: field.Column + len(field.Text) - len(trimmedLeft),
+	}
+}
+
+// Parse the field as an integer, returning a ParseError locating the field if this fails
+func Atoi(day int, line int, field Field) (int, error) {
+	value, err := strconv.Atoi(field.Text)
+	if err != nil {
+		return 0, NewError(day, line, field.Column, field.Text, err)
+	}
+	return value, nil
+}
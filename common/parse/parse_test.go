@@ -0,0 +1,67 @@
+package parse
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestFields(t *testing.T) {
+	fields := Fields(" 83 86  6 31", 26)
+	expected := []Field{{"83", 27}, {"86", 30}, {"6", 34}, {"31", 36}}
+	if len(fields) != len(expected) {
+		t.Fatalf("got %v, expected %v", fields, expected)
+	}
+	for i := range expected {
+		if fields[i] != expected[i] {
+			t.Errorf("field %v: got %v, expected %v", i, fields[i], expected[i])
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	fields := Split("19, 13, 30", ", ", 1)
+	expected := []Field{{"19", 1}, {"13", 5}, {"30", 9}}
+	if len(fields) != len(expected) {
+		t.Fatalf("got %v, expected %v", fields, expected)
+	}
+	for i := range expected {
+		if fields[i] != expected[i] {
+			t.Errorf("field %v: got %v, expected %v", i, fields[i], expected[i])
+		}
+	}
+
+	if trimmed := (Field{"  -2 ", 10}).TrimSpace(); trimmed != (Field{"-2", 12}) {
+		t.Errorf("got %v, expected {-2 12}", trimmed)
+	}
+}
+
+func TestAtoi(t *testing.T) {
+	if value, err := Atoi(4, 1, Field{"42", 3}); err != nil || value != 42 {
+		t.Errorf("got %v %v, expected 42", value, err)
+	}
+
+	_, err := Atoi(4, 7, Field{"4x2", 12})
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("got error %v, expected a ParseError", err)
+	}
+	if parseErr.Day != 4 || parseErr.Line != 7 || parseErr.Column != 12 || parseErr.Text != "4x2" {
+		t.Errorf("got %+v, expected day 4 line 7 column 12 text 4x2", parseErr)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected error to wrap strconv.ErrSyntax")
+	}
+	if message := err.Error(); message != `day 04: line 7, column 12: strconv.Atoi: parsing "4x2": invalid syntax (text "4x2")` {
+		t.Errorf("unexpected error message %v", message)
+	}
+}
+
+func TestErrorUnknownLocation(t *testing.T) {
+	if message := Errorf(8, 0, 0, "", "missing node %q", "AAA").Error(); message != `day 08: missing node "AAA"` {
+		t.Errorf("unexpected error message %v", message)
+	}
+	if message := Errorf(4, 3, 0, "Card 3", "bad card").Error(); message != `day 04: line 3: bad card (text "Card 3")` {
+		t.Errorf("unexpected error message %v", message)
+	}
+}
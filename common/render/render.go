@@ -0,0 +1,103 @@
+// Rendering of grid-based puzzle state to PNG images, with a color per cell type
+// and overlays for cells of interest such as paths.
+package render
+
+import (
+	"fmt"
+	"hmcalister/aocCommon/grid"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// The width and height in pixels of each cell, so small grids are still visible
+const DEFAULT_CELL_SIZE = 4
+
+var (
+	// The color of cells without a color in the palette
+	BACKGROUND_COLOR = color.RGBA{0x10, 0x10, 0x10, 0xff}
+)
+
+// The color to draw each type of cell in a grid
+type Palette[T comparable] map[T]color.Color
+
+// An image of a grid, drawn a cell at a time
+type Canvas struct {
+	Image    *image.RGBA
+	Width    int
+	Height   int
+	CellSize int
+}
+
+// Create a canvas of width by height cells filled with BACKGROUND_COLOR
+func NewCanvas(width int, height int, cellSize int) *Canvas {
+	canvas := &Canvas{
+		Image:    image.NewRGBA(image.Rect(0, 0, width*cellSize, height*cellSize)),
+		Width:    width,
+		Height:   height,
+		CellSize: cellSize,
+	}
+	draw.Draw(canvas.Image, canvas.Image.Bounds(), image.NewUniform(BACKGROUND_COLOR), image.Point{}, draw.Src)
+	return canvas
+}
+
+// Draw each cell of the grid in its color from the palette
+func Grid[T comparable](cells *grid.Grid[T], palette Palette[T], cellSize int) *Canvas {
+	canvas := NewCanvas(cells.Width, cells.Height, cellSize)
+	for y := 0; y < cells.Height; y += 1 {
+		for x, cell := range cells.Row(y) {
+			if cellColor, ok := palette[cell]; ok {
+				canvas.FillCell(grid.Point{X: x, Y: y}, cellColor)
+			}
+		}
+	}
+	return canvas
+}
+
+// Draw over a cell, blending the color with the cell if it is translucent
+//
+// Points outside the canvas are ignored
+func (canvas *Canvas) FillCell(point grid.Point, cellColor color.Color) {
+	if point.X < 0 || point.X >= canvas.Width || point.Y < 0 || point.Y >= canvas.Height {
+		return
+	}
+	cellBounds := image.Rect(point.X*canvas.CellSize, point.Y*canvas.CellSize, (point.X+1)*canvas.CellSize, (point.Y+1)*canvas.CellSize)
+	draw.Draw(canvas.Image, cellBounds, image.NewUniform(cellColor), image.Point{}, draw.Over)
+}
+
+// Draw over every given cell in the same color, such as to show a path
+func (canvas *Canvas) Overlay(points []grid.Point, overlayColor color.Color) {
+	for _, point := range points {
+		canvas.FillCell(point, overlayColor)
+	}
+}
+
+// Encode the canvas as a PNG file, replacing the file if it exists
+func (canvas *Canvas) WritePNG(filePath string) error {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(file, canvas.Image); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// Parse a color of the form "#70c710"
+func ParseHexColor(s string) (color.RGBA, error) {
+	hexDigits, ok := strings.CutPrefix(s, "#")
+	if !ok || len(hexDigits) != 6 {
+		return color.RGBA{}, fmt.Errorf("expected color of the form \"#70c710\", found %q", s)
+	}
+	value, err := strconv.ParseUint(hexDigits, 16, 32)
+	if err != nil {
+		return color.RGBA{}, fmt.Errorf("expected color of the form \"#70c710\", found %q", s)
+	}
+	return color.RGBA{uint8(value >> 16), uint8(value >> 8), uint8(value), 0xff}, nil
+}
@@ -0,0 +1,76 @@
+package render
+
+import (
+	"hmcalister/aocCommon/grid"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGrid(t *testing.T) {
+	cells, err := grid.FromRows([][]rune{
+		[]rune("#.O"),
+		[]rune(".#?"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wall := color.RGBA{0xff, 0, 0, 0xff}
+	rock := color.RGBA{0, 0, 0xff, 0xff}
+	palette := Palette[rune]{'#': wall, '.': color.White, 'O': rock}
+
+	canvas := Grid(cells, palette, 2)
+	canvas.Overlay([]grid.Point{{X: 1, Y: 0}, {X: 5, Y: 5}}, color.RGBA{0, 0x80, 0, 0x80})
+
+	if bounds := canvas.Image.Bounds(); bounds.Dx() != 6 || bounds.Dy() != 4 {
+		t.Fatalf("got image bounds %v, expected 6x4", bounds)
+	}
+	testCases := []struct {
+		x, y     int
+		expected color.RGBA
+	}{
+		{0, 0, wall},
+		{1, 1, wall},
+		{4, 0, rock},
+		{2, 2, wall},
+		{5, 3, BACKGROUND_COLOR},
+		// White with green drawn over at half opacity
+		{2, 0, color.RGBA{0x7f, 0xff, 0x7f, 0xff}},
+	}
+	for _, testCase := range testCases {
+		if pixel := canvas.Image.RGBAAt(testCase.x, testCase.y); pixel != testCase.expected {
+			t.Errorf("got pixel %v at (%v, %v), expected %v", pixel, testCase.x, testCase.y, testCase.expected)
+		}
+	}
+
+	filePath := filepath.Join(t.TempDir(), "out.png")
+	if err := canvas.WritePNG(filePath); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Open(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	decoded, err := png.Decode(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Bounds() != canvas.Image.Bounds() {
+		t.Errorf("got decoded bounds %v, expected %v", decoded.Bounds(), canvas.Image.Bounds())
+	}
+}
+
+func TestParseHexColor(t *testing.T) {
+	parsed, err := ParseHexColor("#70c710")
+	if err != nil || parsed != (color.RGBA{0x70, 0xc7, 0x10, 0xff}) {
+		t.Errorf("got %v (error %v), expected #70c710", parsed, err)
+	}
+	for _, s := range []string{"70c710", "#70c71", "#70c7100", "#70c71g", "#-0c710"} {
+		if _, err := ParseHexColor(s); err == nil {
+			t.Errorf("expected error parsing %q", s)
+		}
+	}
+}
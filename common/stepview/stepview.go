@@ -0,0 +1,157 @@
+// Records the state of a step-based simulation after each step, and lets the steps be
+// viewed one at a time in a terminal using plain ANSI escape codes.
+package stepview
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// The number of steps to record by default, as every frame keeps a copy of the state
+const DEFAULT_MAX_FRAMES = 5000
+
+const (
+	ANSI_CLEAR_SCREEN = "\x1b[H\x1b[2J"
+	ANSI_BOLD         = "\x1b[1m"
+	ANSI_DIM          = "\x1b[2m"
+	ANSI_RED          = "\x1b[31m"
+	ANSI_RESET        = "\x1b[0m"
+)
+
+const HELP_TEXT = "enter/n next, p previous, +N/-N jump N steps, g N go to step N, f first, l last, q quit"
+
+// The state of a simulation after a step
+type Frame struct {
+	Description string
+	State       string
+}
+
+// Collects a frame for each step of a simulation, up to a maximum number of frames
+type Recorder struct {
+	Frames    []Frame
+	MaxFrames int
+
+	// The number of steps taken after the recorder was full, which have no frame
+	DroppedSteps int
+}
+
+func NewRecorder(maxFrames int) *Recorder {
+	return &Recorder{
+		Frames:    make([]Frame, 0),
+		MaxFrames: maxFrames,
+	}
+}
+
+// Record a step of the simulation
+//
+// The state is only formatted if the recorder is not full, so recording a long simulation stays cheap
+func (recorder *Recorder) Record(description string, formatState func() string) {
+	if len(recorder.Frames) >= recorder.MaxFrames {
+		recorder.DroppedSteps += 1
+		return
+	}
+	recorder.Frames = append(recorder.Frames, Frame{
+		Description: description,
+		State:       formatState(),
+	})
+}
+
+// Steps through the frames of a recorder, reading a command per line of input
+type Viewer struct {
+	recorder     *Recorder
+	currentIndex int
+	message      string
+}
+
+func NewViewer(recorder *Recorder) *Viewer {
+	return &Viewer{
+		recorder: recorder,
+	}
+}
+
+// Show the current frame and read commands until the input ends or the quit command is given
+func (viewer *Viewer) Run(in io.Reader, out io.Writer) error {
+	if len(viewer.recorder.Frames) == 0 {
+		_, err := fmt.Fprintln(out, "no steps were recorded")
+		return err
+	}
+
+	commandScanner := bufio.NewScanner(in)
+	for {
+		if err := viewer.show(out); err != nil {
+			return err
+		}
+		if !commandScanner.Scan() {
+			return commandScanner.Err()
+		}
+		if quit := viewer.handleCommand(strings.TrimSpace(commandScanner.Text())); quit {
+			return nil
+		}
+	}
+}
+
+// Move to the frame at the given index, staying within the recorded frames
+func (viewer *Viewer) moveTo(frameIndex int) {
+	viewer.currentIndex = max(0, min(frameIndex, len(viewer.recorder.Frames)-1))
+}
+
+// Apply a command, returning true if the viewer should stop
+func (viewer *Viewer) handleCommand(command string) bool {
+	viewer.message = ""
+	switch {
+	case command == "" || command == "n":
+		viewer.moveTo(viewer.currentIndex + 1)
+	case command == "p":
+		viewer.moveTo(viewer.currentIndex - 1)
+	case command == "f":
+		viewer.moveTo(0)
+	case command == "l":
+		viewer.moveTo(len(viewer.recorder.Frames) - 1)
+	case command == "q":
+		return true
+	case strings.HasPrefix(command, "+") || strings.HasPrefix(command, "-"):
+		steps, err := strconv.Atoi(command)
+		if err != nil {
+			viewer.message = fmt.Sprintf("expected a number of steps to jump, found %q", command)
+			break
+		}
+		viewer.moveTo(viewer.currentIndex + steps)
+	case strings.HasPrefix(command, "g"):
+		step, err := strconv.Atoi(strings.TrimSpace(command[1:]))
+		if err != nil {
+			viewer.message = fmt.Sprintf("expected a step to go to, found %q", command)
+			break
+		}
+		viewer.moveTo(step)
+	default:
+		viewer.message = fmt.Sprintf("unknown command %q", command)
+	}
+	return false
+}
+
+func (viewer *Viewer) show(out io.Writer) error {
+	frame := viewer.recorder.Frames[viewer.currentIndex]
+
+	var screen strings.Builder
+	screen.WriteString(ANSI_CLEAR_SCREEN)
+	fmt.Fprintf(&screen, "%vStep %v of %v%v  %v\n", ANSI_BOLD, viewer.currentIndex, len(viewer.recorder.Frames)-1, ANSI_RESET, frame.Description)
+	if viewer.recorder.DroppedSteps > 0 {
+		fmt.Fprintf(&screen, "%v%v later steps were not recorded%v\n", ANSI_DIM, viewer.recorder.DroppedSteps, ANSI_RESET)
+	}
+	screen.WriteString("\n")
+	screen.WriteString(frame.State)
+	if !strings.HasSuffix(frame.State, "\n") {
+		screen.WriteString("\n")
+	}
+	screen.WriteString("\n")
+	if viewer.message != "" {
+		fmt.Fprintf(&screen, "%v%v%v\n", ANSI_RED, viewer.message, ANSI_RESET)
+	}
+	fmt.Fprintf(&screen, "%v%v%v\n> ", ANSI_DIM, HELP_TEXT, ANSI_RESET)
+
+	_, err := io.WriteString(out, screen.String())
+	return err
+}
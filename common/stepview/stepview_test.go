@@ -0,0 +1,78 @@
+package stepview
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func recordSteps(numSteps int, maxFrames int) *Recorder {
+	recorder := NewRecorder(maxFrames)
+	for i := 0; i < numSteps; i += 1 {
+		recorder.Record(fmt.Sprintf("step %v", i), func() string { return fmt.Sprintf("state %v", i) })
+	}
+	return recorder
+}
+
+func TestRecorder(t *testing.T) {
+	formatted := 0
+	recorder := NewRecorder(3)
+	for i := 0; i < 5; i += 1 {
+		recorder.Record("step", func() string {
+			formatted += 1
+			return ""
+		})
+	}
+	if len(recorder.Frames) != 3 || recorder.DroppedSteps != 2 || formatted != 3 {
+		t.Errorf("got %v frames, %v dropped steps and %v states formatted, expected 3, 2 and 3", len(recorder.Frames), recorder.DroppedSteps, formatted)
+	}
+}
+
+func TestViewer(t *testing.T) {
+	testCases := []struct {
+		commands string
+		expected string
+	}{
+		{"", "state 0"},
+		{"\n", "state 1"},
+		{"n\nn\np\n", "state 1"},
+		{"+5\n", "state 5"},
+		{"+5\n-2\n", "state 3"},
+		{"+100\n", "state 9"},
+		{"-3\n", "state 0"},
+		{"g 7\n", "state 7"},
+		{"g7\nf\n", "state 0"},
+		{"l\n", "state 9"},
+		{"+2\nq\n+2\n", "state 2"},
+	}
+	for _, testCase := range testCases {
+		var out bytes.Buffer
+		if err := NewViewer(recordSteps(10, 100)).Run(strings.NewReader(testCase.commands), &out); err != nil {
+			t.Fatal(err)
+		}
+		screens := strings.Split(out.String(), ANSI_CLEAR_SCREEN)
+		lastScreen := screens[len(screens)-1]
+		if !strings.Contains(lastScreen, testCase.expected+"\n") {
+			t.Errorf("commands %q: got last screen %q, expected it to show %v", testCase.commands, lastScreen, testCase.expected)
+		}
+	}
+}
+
+func TestViewerMessages(t *testing.T) {
+	var out bytes.Buffer
+	if err := NewViewer(recordSteps(3, 2)).Run(strings.NewReader("jump\n"), &out); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "unknown command \"jump\"") {
+		t.Errorf("expected unknown command message, got %q", out.String())
+	}
+	if !strings.Contains(out.String(), "1 later steps were not recorded") {
+		t.Errorf("expected dropped steps message, got %q", out.String())
+	}
+
+	out.Reset()
+	if err := NewViewer(NewRecorder(5)).Run(strings.NewReader(""), &out); err != nil || out.String() != "no steps were recorded\n" {
+		t.Errorf("got %q (error %v) for an empty recorder", out.String(), err)
+	}
+}
@@ -0,0 +1,87 @@
+// Checks on the structure of puzzle input, so an input breaking the assumptions of a
+// solution is reported line by line before solving, rather than giving a wrong answer.
+package validate
+
+import (
+	"bufio"
+	"errors"
+	"hmcalister/aocCommon/parse"
+	"regexp"
+	"strings"
+)
+
+// Check the structure of a puzzle input, returning every violation found rather than stopping at the first
+type ValidatorFunc func(fileScanner *bufio.Scanner) []*parse.ParseError
+
+// Collects the violations found while validating the input of a day
+type Report struct {
+	Day        int
+	Violations []*parse.ParseError
+}
+
+func NewReport(day int) *Report {
+	return &Report{
+		Day:        day,
+		Violations: make([]*parse.ParseError, 0),
+	}
+}
+
+// Add a violation at the given location, with a message formatted as in fmt.Errorf
+//
+// Line and column are zero for violations of the input as a whole
+func (report *Report) Addf(line int, column int, text string, format string, args ...any) {
+	report.Violations = append(report.Violations, parse.Errorf(report.Day, line, column, text, format, args...))
+}
+
+// Add a violation from an error, such as those returned by parse.Atoi
+//
+// Errors that are not a ParseError are added without a location
+func (report *Report) Add(err error) {
+	var parseErr *parse.ParseError
+	if !errors.As(err, &parseErr) {
+		parseErr = parse.NewError(report.Day, 0, 0, "", err)
+	}
+	report.Violations = append(report.Violations, parseErr)
+}
+
+func (report *Report) Valid() bool {
+	return len(report.Violations) == 0
+}
+
+// Read all lines of the input, for validators that need to look at the input more than once
+func ReadLines(fileScanner *bufio.Scanner) []string {
+	lines := make([]string, 0)
+	for fileScanner.Scan() {
+		lines = append(lines, fileScanner.Text())
+	}
+	return lines
+}
+
+// Add a violation for each rune of the line that is not one of the allowed runes
+func (report *Report) CheckRunes(line string, lineNumber int, allowed string) {
+	for runeIndex, r := range line {
+		if !strings.ContainsRune(allowed, r) {
+			report.Addf(lineNumber, runeIndex+1, line, "unexpected rune %q, expected one of %q", r, allowed)
+		}
+	}
+}
+
+// Add a violation if the line does not match the pattern, describing the expected form of the line
+func (report *Report) CheckPattern(line string, lineNumber int, pattern *regexp.Regexp, expectedForm string) {
+	if !pattern.MatchString(line) {
+		report.Addf(lineNumber, 1, line, "expected line of the form %q", expectedForm)
+	}
+}
+
+// Add a violation for each line that is not the same length as the first
+func (report *Report) CheckUniformWidth(lines []string) {
+	if len(lines) == 0 {
+		report.Addf(0, 0, "", "input is empty")
+		return
+	}
+	for lineIndex, line := range lines {
+		if len(line) != len(lines[0]) {
+			report.Addf(lineIndex+1, 0, line, "line has length %v, expected %v as on line 1", len(line), len(lines[0]))
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package validate
+
+import (
+	"bufio"
+	"errors"
+	"hmcalister/aocCommon/parse"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestReport(t *testing.T) {
+	report := NewReport(3)
+	if !report.Valid() {
+		t.Errorf("expected new report to be valid")
+	}
+
+	report.Addf(2, 5, "..x..", "unexpected rune %q", 'x')
+	_, err := parse.Atoi(3, 4, parse.Field{Text: "1a", Column: 2})
+	report.Add(err)
+	report.Add(errors.New("input is empty"))
+
+	if report.Valid() || len(report.Violations) != 3 {
+		t.Fatalf("got %v violations, expected 3", len(report.Violations))
+	}
+	expectedMessages := []string{
+		`day 03: line 2, column 5: unexpected rune 'x' (text "..x..")`,
+		`day 03: line 4, column 2: strconv.Atoi: parsing "1a": invalid syntax (text "1a")`,
+		`day 03: input is empty`,
+	}
+	for i, expectedMessage := range expectedMessages {
+		if message := report.Violations[i].Error(); message != expectedMessage {
+			t.Errorf("got violation %v, expected %v", message, expectedMessage)
+		}
+	}
+}
+
+func TestChecks(t *testing.T) {
+	lines := ReadLines(bufio.NewScanner(strings.NewReader("#.#\n..\n#x#\n")))
+	if len(lines) != 3 {
+		t.Fatalf("got %v lines, expected 3", len(lines))
+	}
+
+	report := NewReport(23)
+	report.CheckUniformWidth(lines)
+	for lineIndex, line := range lines {
+		report.CheckRunes(line, lineIndex+1, "#.")
+	}
+
+	if len(report.Violations) != 2 {
+		t.Fatalf("got violations %v, expected 2", report.Violations)
+	}
+	if violation := report.Violations[0]; violation.Line != 2 || violation.Column != 0 {
+		t.Errorf("got width violation %v, expected line 2", violation)
+	}
+	if violation := report.Violations[1]; violation.Line != 3 || violation.Column != 2 {
+		t.Errorf("got rune violation %v, expected line 3 column 2", violation)
+	}
+
+	patternReport := NewReport(20)
+	moduleRegexp := regexp.MustCompile(`^[%&]?[a-z]+ -> [a-z]+(, [a-z]+)*$`)
+	patternReport.CheckPattern("%a -> b, c", 1, moduleRegexp, "%a -> b, c")
+	patternReport.CheckPattern("%a -> ", 2, moduleRegexp, "%a -> b, c")
+	if len(patternReport.Violations) != 1 || patternReport.Violations[0].Line != 2 {
+		t.Errorf("got pattern violations %v, expected one on line 2", patternReport.Violations)
+	}
+
+	emptyReport := NewReport(23)
+	emptyReport.CheckUniformWidth(nil)
+	if emptyReport.Valid() {
+		t.Errorf("expected empty input to be invalid")
+	}
+}
@@ -0,0 +1,43 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+	"strings"
+)
+
+const DAY = 1
+
+var DIGIT_NAMES = []string{"one", "two", "three", "four", "five", "six", "seven", "eight", "nine"}
+
+// Check each line is made of lowercase letters and digits, and contains a digit either as a
+// digit or spelled out. Only part 1 needs an actual digit, so that is reported when solving part 1
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+	lines := validate.ReadLines(fileScanner)
+
+	if len(lines) == 0 {
+		report.Addf(0, 0, "", "input is empty")
+	}
+	for lineIndex, line := range lines {
+		report.CheckRunes(line, lineIndex+1, "abcdefghijklmnopqrstuvwxyz0123456789")
+		if !containsDigit(line) {
+			report.Addf(lineIndex+1, 0, line, "expected line to contain a digit")
+		}
+	}
+
+	return report.Violations
+}
+
+func containsDigit(line string) bool {
+	if strings.ContainsAny(line, "0123456789") {
+		return true
+	}
+	for _, digitName := range DIGIT_NAMES {
+		if strings.Contains(line, digitName) {
+			return true
+		}
+	}
+	return false
+}
@@ -5,6 +5,7 @@ go 1.21.0
 require (
 	github.com/rs/zerolog v1.31.0
 	golang.org/x/sync v0.5.0
+	hmcalister/aocCommon v0.0.0
 )
 
 require (
@@ -12,3 +13,5 @@ require (
 	github.com/mattn/go-isatty v0.0.20 // indirect
 	golang.org/x/sys v0.15.0 // indirect
 )
+
+replace hmcalister/aocCommon => ../../common
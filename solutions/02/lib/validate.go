@@ -0,0 +1,29 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+	"regexp"
+)
+
+const DAY = 2
+
+// Each game is a semicolon separated list of trials,
+// and each trial a comma separated list of cube counts
+var gameLineRegexp = regexp.MustCompile(`^Game [0-9]+: [0-9]+ (red|green|blue)((, |; )[0-9]+ (red|green|blue))*$`)
+
+// Check each line is a game of the form "Game 1: 3 blue, 4 red; 1 red, 2 green"
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+	lines := validate.ReadLines(fileScanner)
+
+	if len(lines) == 0 {
+		report.Addf(0, 0, "", "input is empty")
+	}
+	for lineIndex, line := range lines {
+		report.CheckPattern(line, lineIndex+1, gameLineRegexp, "Game 1: 3 blue, 4 red; 1 red, 2 green")
+	}
+
+	return report.Violations
+}
@@ -0,0 +1,26 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+)
+
+const (
+	DAY = 3
+
+	SYMBOLS = "$@/*#=+-&%"
+)
+
+// Check the schematic is rectangular and contains only periods, digits, and known symbols
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+	lines := validate.ReadLines(fileScanner)
+
+	report.CheckUniformWidth(lines)
+	for lineIndex, line := range lines {
+		report.CheckRunes(line, lineIndex+1, ".0123456789"+SYMBOLS)
+	}
+
+	return report.Violations
+}
@@ -2,20 +2,16 @@ package part01
 
 import (
 	"bufio"
+	"hmcalister/aoc03/lib"
+	"hmcalister/aocCommon/grid"
+	"hmcalister/aocCommon/parse"
 	"strings"
 
-	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
 
-const (
-	LINE_LENGTH = 141
-)
-
 var (
-	COORD_OFFSETS = [3]int{-1, 0, 1}
-	SYMBOLS       = "$@/*#=+-&%"
-	DIGITS        = "0123456789"
+	DIGITS = "0123456789"
 )
 
 type partNumberData struct {
@@ -26,32 +22,30 @@ type partNumberData struct {
 	Counted bool
 }
 
-// A map from a coordinate to the corresponding part number (if one exists at that coordinate)
-//
-// Note that indices into this map should be of the form (LINE_LENGTH*y + x) to linearize the coordinates.
-var partNumberMap map[int]*partNumberData
-
-// A list of positions in which symbols are found.
-var symbolCoordinateList []int
-
 // Given a scanner over the puzzle input, calculate the sum of the part numbers.
 //
 // This is done by finding all numbers adjacent (incl. diagonally) with a symbol (non-period characters).
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 	result := 0
-	partNumberMap = make(map[int]*partNumberData)
-	symbolCoordinateList = make([]int, 0)
+	// A map from a coordinate to the corresponding part number (if one exists at that coordinate)
+	partNumberMap := make(map[grid.Point]*partNumberData)
+	// A list of positions in which symbols are found.
+	symbolCoordinateList := make([]grid.Point, 0)
 	var currentRune rune
 
-	lineNumber := 0
+	schematic, err := grid.ParseRunes(lib.DAY, fileScanner)
+	if err != nil {
+		return 0, err
+	}
+
 	// Walk over each line and find the symbols and part numbers,
 	// storing each in the respective map and list.
-	for fileScanner.Scan() {
+	for lineNumber := 0; lineNumber < schematic.Height; lineNumber += 1 {
 		log.Debug().Int("LineNumber", lineNumber).Send()
-		line := fileScanner.Text()
+		line := schematic.Row(lineNumber)
 
 		for colIndex := 0; colIndex < len(line); colIndex += 1 {
-			currentRune = rune(line[colIndex])
+			currentRune = line[colIndex]
 
 			// We are inspecting a new character. The options are:
 			// - period: Do nothing, carry on
@@ -66,16 +60,16 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 					Str("Symbol", ".").
 					Send()
 				continue
-			} else if strings.ContainsRune(SYMBOLS, currentRune) {
+			} else if strings.ContainsRune(lib.SYMBOLS, currentRune) {
 				log.Trace().
 					Int("ColIndex", colIndex).
 					Str("Symbol", string(currentRune)).
 					Send()
-				symbolCoordinateList = append(symbolCoordinateList, LINE_LENGTH*lineNumber+colIndex)
+				symbolCoordinateList = append(symbolCoordinateList, grid.Point{X: colIndex, Y: lineNumber})
 			} else if strings.ContainsRune(DIGITS, currentRune) {
 				currentData := &partNumberData{}
 				for colIndex < len(line) {
-					currentRune = rune(line[colIndex])
+					currentRune = line[colIndex]
 					if !strings.ContainsRune(DIGITS, currentRune) {
 						break
 					}
@@ -89,22 +83,20 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 						Int("ParsedInt", currentDigit).
 						Int("CumulativeInt", currentData.Number).
 						Send()
-					partNumberMap[LINE_LENGTH*lineNumber+colIndex] = currentData
+					partNumberMap[grid.Point{X: colIndex, Y: lineNumber}] = currentData
 
 					colIndex += 1
 				}
 				colIndex -= 1
 				log.Debug().
 					Int("ColIndex", colIndex).
-					Int("LinearCoordinate", LINE_LENGTH*lineNumber+colIndex).
+					Stringer("Coordinate", grid.Point{X: colIndex, Y: lineNumber}).
 					Int("FoundNumber", currentData.Number).
 					Send()
 			} else {
-				log.Panic().Msgf("found unexpected symbol: %v", string(currentRune))
+				return 0, parse.Errorf(lib.DAY, lineNumber+1, colIndex+1, string(currentRune), "found unexpected symbol")
 			}
 		}
-
-		lineNumber += 1
 	}
 	// We now have the locations of all symbols, as well as a hashmap from
 	// location to partNumbers. The rest is easy! Look at each symbol location,
@@ -114,20 +106,17 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 	// "outside" the schematic, i.e. we don't have to do any boundary checking!
 
 	for _, symbolLocation := range symbolCoordinateList {
-		for _, delX := range COORD_OFFSETS {
-			for _, delY := range COORD_OFFSETS {
-				currentCoord := symbolLocation + (LINE_LENGTH*delY + delX)
-				partNumber, ok := partNumberMap[currentCoord]
-				if ok && !partNumber.Counted {
-					partNumber.Counted = true
-					result += partNumber.Number
-					log.Debug().
-						Int("CurrentLinearCoordinate", currentCoord).
-						Array("EffectiveCartesianCoordinates", zerolog.Arr().Int(currentCoord%LINE_LENGTH).Int(currentCoord/LINE_LENGTH)).
-						Int("FoundPartNumber", partNumber.Number).
-						Int("NewCount", result).
-						Send()
-				}
+		for _, direction := range grid.ALL_DIRECTIONS {
+			currentCoord := symbolLocation.Move(direction)
+			partNumber, ok := partNumberMap[currentCoord]
+			if ok && !partNumber.Counted {
+				partNumber.Counted = true
+				result += partNumber.Number
+				log.Debug().
+					Stringer("CurrentCoordinate", currentCoord).
+					Int("FoundPartNumber", partNumber.Number).
+					Int("NewCount", result).
+					Send()
 			}
 		}
 	}
@@ -2,26 +2,22 @@ package part02
 
 import (
 	"bufio"
+	"hmcalister/aoc03/lib"
+	"hmcalister/aocCommon/grid"
 	"slices"
 	"strings"
 
-	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
 
 const (
-	LINE_LENGTH = 141
 	DIGITS      = "0123456789"
 	GEAR_SYMBOL = '*'
 )
 
-var (
-	COORD_OFFSETS = [3]int{-1, 0, 1}
-)
-
 type gearData struct {
-	// The position of this gear, linearized
-	Location int
+	// The position of this gear
+	Location grid.Point
 
 	// The gear ratio (product of all nearby numbers)
 	Ratio int
@@ -38,35 +34,28 @@ type partNumberData struct {
 	Number int
 }
 
-// An array of partNumbers
-var partNumberArray []*partNumberData
-
-// An array of gearData
-var gearDataArray []*gearData
-
-// A map from a coordinate to the corresponding part number (if one exists at that coordinate)
-//
-// Note that indices into this map should be of the form (LINE_LENGTH*y + x) to linearize the coordinates.
-var partNumberMap map[int]*partNumberData
-
 // Given a scanner over the puzzle input, calculate the sum of the part numbers.
 //
 // This is done by finding all numbers adjacent (incl. diagonally) with a symbol (non-period characters).
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 	result := 0
 	var currentRune rune
-	partNumberMap = make(map[int]*partNumberData)
-	partNumberArray = make([]*partNumberData, 0)
-	gearDataArray = make([]*gearData, 0)
+	// A map from a coordinate to the corresponding part number (if one exists at that coordinate)
+	partNumberMap := make(map[grid.Point]*partNumberData)
+	gearDataArray := make([]*gearData, 0)
+
+	schematic, err := grid.ParseRunes(lib.DAY, fileScanner)
+	if err != nil {
+		return 0, err
+	}
 
-	lineNumber := 0
 	currentPartID := 0
-	for fileScanner.Scan() {
+	for lineNumber := 0; lineNumber < schematic.Height; lineNumber += 1 {
 		log.Debug().Int("LineNumber", lineNumber).Send()
-		line := fileScanner.Text()
+		line := schematic.Row(lineNumber)
 
 		for colIndex := 0; colIndex < len(line); colIndex += 1 {
-			currentRune = rune(line[colIndex])
+			currentRune = line[colIndex]
 
 			// We are inspecting a new character. The options are:
 			// - Gear Symbol: Add this coordinate to the gear list and carry on
@@ -80,7 +69,7 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 					Str("Symbol", string(GEAR_SYMBOL)).
 					Send()
 				gearDataArray = append(gearDataArray, &gearData{
-					Location:        LINE_LENGTH*lineNumber + colIndex,
+					Location:        grid.Point{X: colIndex, Y: lineNumber},
 					Ratio:           1,
 					UniqueNeighbors: make([]int, 0),
 				})
@@ -91,7 +80,7 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 				currentPartID += 1
 
 				for colIndex < len(line) {
-					currentRune = rune(line[colIndex])
+					currentRune = line[colIndex]
 					if !strings.ContainsRune(DIGITS, currentRune) {
 						break
 					}
@@ -105,14 +94,14 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 						Int("ParsedInt", currentDigit).
 						Int("CumulativeInt", currentData.Number).
 						Send()
-					partNumberMap[LINE_LENGTH*lineNumber+colIndex] = currentData
+					partNumberMap[grid.Point{X: colIndex, Y: lineNumber}] = currentData
 
 					colIndex += 1
 				}
 				colIndex -= 1
 				log.Debug().
 					Int("ColIndex", colIndex).
-					Int("LinearCoordinate", LINE_LENGTH*lineNumber+colIndex).
+					Stringer("Coordinate", grid.Point{X: colIndex, Y: lineNumber}).
 					Int("FoundNumber", currentData.Number).
 					Send()
 			} else {
@@ -123,7 +112,6 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 			}
 
 		}
-		lineNumber += 1
 	}
 
 	// We now have the locations of all gears, as well as a hashmap from
@@ -131,25 +119,20 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 	// the gear ratio as well as the unique partNumbers around it.
 	for _, gear := range gearDataArray {
 		log.Debug().
-			Int("CurrentGearLocation", gear.Location).
-			Array("EffectiveCartesianCoordinates", zerolog.Arr().
-				Int(gear.Location%LINE_LENGTH).
-				Int(gear.Location/LINE_LENGTH)).
+			Stringer("CurrentGearLocation", gear.Location).
 			Send()
-		for _, delX := range COORD_OFFSETS {
-			for _, delY := range COORD_OFFSETS {
-				currentCoord := gear.Location + (LINE_LENGTH*delY + delX)
-				partNumber, ok := partNumberMap[currentCoord]
-				if ok && !slices.Contains(gear.UniqueNeighbors, partNumber.PartID) {
-					// We have found a part that is not yet counted as a neighbor!
-					gear.UniqueNeighbors = append(gear.UniqueNeighbors, partNumber.PartID)
-					gear.Ratio *= partNumber.Number
-
-					log.Debug().
-						Int("FoundPartID", partNumber.PartID).
-						Int("NewRatio", gear.Ratio).
-						Send()
-				}
+		for _, direction := range grid.ALL_DIRECTIONS {
+			currentCoord := gear.Location.Move(direction)
+			partNumber, ok := partNumberMap[currentCoord]
+			if ok && !slices.Contains(gear.UniqueNeighbors, partNumber.PartID) {
+				// We have found a part that is not yet counted as a neighbor!
+				gear.UniqueNeighbors = append(gear.UniqueNeighbors, partNumber.PartID)
+				gear.Ratio *= partNumber.Number
+
+				log.Debug().
+					Int("FoundPartID", partNumber.PartID).
+					Int("NewRatio", gear.Ratio).
+					Send()
 			}
 		}
 
@@ -0,0 +1,73 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+	"slices"
+	"strings"
+)
+
+const DAY = 4
+
+// Check the cards are numbered from one in order,
+// and that no card wins copies of cards past the end of the table
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+
+	lines := make([]string, 0)
+	scores := make([]int, 0)
+	lineNumber := 0
+	for fileScanner.Scan() {
+		lineNumber += 1
+		line := fileScanner.Text()
+		lines = append(lines, line)
+		scores = append(scores, 0)
+
+		colonIndex := strings.IndexRune(line, ':')
+		barIndex := strings.IndexRune(line, '|')
+		if !strings.HasPrefix(line, "Card ") || colonIndex == -1 || barIndex < colonIndex {
+			report.Addf(lineNumber, 1, line, "expected line of the form \"Card X: winning numbers | found numbers\"")
+			continue
+		}
+
+		cardID, err := parse.Atoi(DAY, lineNumber, parse.Field{Text: line[5:colonIndex], Column: 6}.TrimSpace())
+		if err != nil {
+			report.Add(err)
+		} else if cardID != lineNumber {
+			report.Addf(lineNumber, 6, line, "found card %v, expected card %v", cardID, lineNumber)
+		}
+
+		winningNumbers := parseNumbers(report, line[colonIndex+1:barIndex], lineNumber, colonIndex+2)
+		foundNumbers := parseNumbers(report, line[barIndex+1:], lineNumber, barIndex+2)
+		for _, n := range foundNumbers {
+			if slices.Contains(winningNumbers, n) {
+				scores[lineNumber-1] += 1
+			}
+		}
+	}
+
+	if lineNumber == 0 {
+		report.Addf(0, 0, "", "input is empty")
+	}
+	for lineIndex, score := range scores {
+		if lineIndex+1+score > len(lines) {
+			report.Addf(lineIndex+1, 0, lines[lineIndex], "card wins copies up to card %v, past the last card %v", lineIndex+1+score, len(lines))
+		}
+	}
+
+	return report.Violations
+}
+
+func parseNumbers(report *validate.Report, s string, lineNumber int, startColumn int) []int {
+	numbers := make([]int, 0)
+	for _, field := range parse.Fields(s, startColumn) {
+		n, err := parse.Atoi(DAY, lineNumber, field)
+		if err != nil {
+			report.Add(err)
+			continue
+		}
+		numbers = append(numbers, n)
+	}
+	return numbers
+}
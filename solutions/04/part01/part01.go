@@ -2,13 +2,15 @@ package part01
 
 import (
 	"bufio"
+	"hmcalister/aocCommon/parse"
 	"slices"
-	"strconv"
 	"strings"
 
 	"github.com/rs/zerolog/log"
 )
 
+const DAY = 4
+
 type scratchCardData struct {
 	CardID         int
 	WinningNumbers []int
@@ -17,39 +19,44 @@ type scratchCardData struct {
 }
 
 // Convert a list of integers as a string (space separated) to an integer array
-func stringToIntArray(s string) []int {
-	var err error
-
-	intStrs := strings.Fields(s)
-	log.Trace().Str("StrToParse", s).Int("NumFieldsFound", len(intStrs)).Send()
-
-	parsedInts := make([]int, len(intStrs))
-	for i, intStr := range intStrs {
-		parsedInts[i], err = strconv.Atoi(intStr)
+//
+// startColumn is the column of the start of s within its line
+func stringToIntArray(s string, lineNumber int, startColumn int) ([]int, error) {
+	intFields := parse.Fields(s, startColumn)
+	log.Trace().Str("StrToParse", s).Int("NumFieldsFound", len(intFields)).Send()
+
+	parsedInts := make([]int, len(intFields))
+	for i, intField := range intFields {
+		parsedInt, err := parse.Atoi(DAY, lineNumber, intField)
 		if err != nil {
-			log.Fatal().Msgf("error when parsing integer %v", err)
+			return nil, err
 		}
+		parsedInts[i] = parsedInt
 	}
 
-	return parsedInts
+	return parsedInts, nil
 }
 
-func createScratchCard(line string) *scratchCardData {
+func createScratchCard(line string, lineNumber int) (*scratchCardData, error) {
 	var err error
 	cardData := &scratchCardData{}
 
 	// The line starts with "Card X:", so we find the card number and strip this away
 	colonIndex := strings.IndexRune(line, ':')
-	cardIDStr := strings.TrimSpace(line[5:colonIndex])
-	cardData.CardID, err = strconv.Atoi(cardIDStr)
+	if !strings.HasPrefix(line, "Card ") || colonIndex == -1 {
+		return nil, parse.Errorf(DAY, lineNumber, 1, line, "expected line to start with \"Card X:\"")
+	}
+	cardIDField := parse.Field{Text: line[5:colonIndex], Column: 6}.TrimSpace()
 	log.Trace().
-		Str("CardIDStr", cardIDStr).
+		Str("CardIDStr", cardIDField.Text).
 		Send()
 
+	cardData.CardID, err = parse.Atoi(DAY, lineNumber, cardIDField)
 	if err != nil {
-		log.Fatal().Msgf("error when parsing cardID: %v", err)
+		return nil, err
 	}
 	line = line[colonIndex+1:]
+	lineColumn := colonIndex + 2
 
 	log.Trace().
 		Int("CardID", cardData.CardID).
@@ -58,6 +65,9 @@ func createScratchCard(line string) *scratchCardData {
 
 	// Next step, separate the two halves, the winning numbers and found numbers
 	barIndex := strings.IndexRune(line, '|')
+	if barIndex == -1 {
+		return nil, parse.Errorf(DAY, lineNumber, lineColumn, line, "expected winning and found numbers separated by \"|\"")
+	}
 	winningNumbersStr := strings.TrimSpace(line[:barIndex])
 	foundNumbersStr := strings.TrimSpace(line[barIndex+1:])
 
@@ -68,8 +78,14 @@ func createScratchCard(line string) *scratchCardData {
 		Str("FoundNumberStr", foundNumbersStr).
 		Send()
 
-	cardData.WinningNumbers = stringToIntArray(winningNumbersStr)
-	cardData.FoundNumbers = stringToIntArray(foundNumbersStr)
+	cardData.WinningNumbers, err = stringToIntArray(line[:barIndex], lineNumber, lineColumn)
+	if err != nil {
+		return nil, err
+	}
+	cardData.FoundNumbers, err = stringToIntArray(line[barIndex+1:], lineNumber, lineColumn+barIndex+1)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, n := range cardData.FoundNumbers {
 		if slices.Contains(cardData.WinningNumbers, n) {
@@ -91,7 +107,7 @@ func createScratchCard(line string) *scratchCardData {
 		Int("FinalScore", cardData.Score).
 		Send()
 
-	return cardData
+	return cardData, nil
 }
 
 // Given a scanner over the input file, calculate the total number of points
@@ -99,9 +115,14 @@ func createScratchCard(line string) *scratchCardData {
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 	result := 0
 
+	lineNumber := 0
 	for fileScanner.Scan() {
+		lineNumber += 1
 		line := fileScanner.Text()
-		cardData := createScratchCard(line)
+		cardData, err := createScratchCard(line, lineNumber)
+		if err != nil {
+			return 0, err
+		}
 		result += cardData.Score
 	}
 	return result, nil
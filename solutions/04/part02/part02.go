@@ -2,15 +2,19 @@ package part02
 
 import (
 	"bufio"
+	"hmcalister/aocCommon/answer"
+	"hmcalister/aocCommon/parse"
+	"math/big"
 	"slices"
-	"strconv"
 	"strings"
 
 	"github.com/rs/zerolog/log"
 )
 
+const DAY = 4
+
 type scratchCardData struct {
-	Copies         int
+	Copies         *big.Int
 	CardID         int
 	WinningNumbers []int
 	FoundNumbers   []int
@@ -18,40 +22,45 @@ type scratchCardData struct {
 }
 
 // Convert a list of integers as a string (space separated) to an integer array
-func stringToIntArray(s string) []int {
-	var err error
-
-	intStrs := strings.Fields(s)
-	log.Trace().Str("StrToParse", s).Int("NumFieldsFound", len(intStrs)).Send()
-
-	parsedInts := make([]int, len(intStrs))
-	for i, intStr := range intStrs {
-		parsedInts[i], err = strconv.Atoi(intStr)
+//
+// startColumn is the column of the start of s within its line
+func stringToIntArray(s string, lineNumber int, startColumn int) ([]int, error) {
+	intFields := parse.Fields(s, startColumn)
+	log.Trace().Str("StrToParse", s).Int("NumFieldsFound", len(intFields)).Send()
+
+	parsedInts := make([]int, len(intFields))
+	for i, intField := range intFields {
+		parsedInt, err := parse.Atoi(DAY, lineNumber, intField)
 		if err != nil {
-			log.Fatal().Msgf("error when parsing integer %v", err)
+			return nil, err
 		}
+		parsedInts[i] = parsedInt
 	}
 
-	return parsedInts
+	return parsedInts, nil
 }
 
-func createScratchCard(line string, numCopies int) *scratchCardData {
+func createScratchCard(line string, lineNumber int, numCopies int) (*scratchCardData, error) {
 	var err error
 	cardData := &scratchCardData{}
-	cardData.Copies = numCopies
+	cardData.Copies = big.NewInt(int64(numCopies))
 
 	// The line starts with "Card X:", so we find the card number and strip this away
 	colonIndex := strings.IndexRune(line, ':')
-	cardIDStr := strings.TrimSpace(line[5:colonIndex])
-	cardData.CardID, err = strconv.Atoi(cardIDStr)
+	if !strings.HasPrefix(line, "Card ") || colonIndex == -1 {
+		return nil, parse.Errorf(DAY, lineNumber, 1, line, "expected line to start with \"Card X:\"")
+	}
+	cardIDField := parse.Field{Text: line[5:colonIndex], Column: 6}.TrimSpace()
 	log.Trace().
-		Str("CardIDStr", cardIDStr).
+		Str("CardIDStr", cardIDField.Text).
 		Send()
 
+	cardData.CardID, err = parse.Atoi(DAY, lineNumber, cardIDField)
 	if err != nil {
-		log.Fatal().Msgf("error when parsing cardID: %v", err)
+		return nil, err
 	}
 	line = line[colonIndex+1:]
+	lineColumn := colonIndex + 2
 
 	log.Trace().
 		Int("CardID", cardData.CardID).
@@ -60,6 +69,9 @@ func createScratchCard(line string, numCopies int) *scratchCardData {
 
 	// Next step, separate the two halves, the winning numbers and found numbers
 	barIndex := strings.IndexRune(line, '|')
+	if barIndex == -1 {
+		return nil, parse.Errorf(DAY, lineNumber, lineColumn, line, "expected winning and found numbers separated by \"|\"")
+	}
 	winningNumbersStr := strings.TrimSpace(line[:barIndex])
 	foundNumbersStr := strings.TrimSpace(line[barIndex+1:])
 
@@ -70,8 +82,14 @@ func createScratchCard(line string, numCopies int) *scratchCardData {
 		Str("FoundNumberStr", foundNumbersStr).
 		Send()
 
-	cardData.WinningNumbers = stringToIntArray(winningNumbersStr)
-	cardData.FoundNumbers = stringToIntArray(foundNumbersStr)
+	cardData.WinningNumbers, err = stringToIntArray(line[:barIndex], lineNumber, lineColumn)
+	if err != nil {
+		return nil, err
+	}
+	cardData.FoundNumbers, err = stringToIntArray(line[barIndex+1:], lineNumber, lineColumn+barIndex+1)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, n := range cardData.FoundNumbers {
 		if slices.Contains(cardData.WinningNumbers, n) {
@@ -89,35 +107,39 @@ func createScratchCard(line string, numCopies int) *scratchCardData {
 		Int("FinalScore", cardData.Score).
 		Send()
 
-	return cardData
+	return cardData, nil
 }
 
-// Given a scanner over the input file, calculate the total number of points
-// that the scratchcards have earned, and return it
-func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	cardCopiesArray := make([]int, 198)
-	for i := range cardCopiesArray {
-		cardCopiesArray[i] = 1
-	}
-
+// Given a scanner over the input file, calculate the total number of scratchcards
+// held once all copies have been won, and return it
+//
+// Copies are only ever won of cards further down the table, so the copies of each card
+// are known by the time it is reached. Copies of cards past the end of the table are not counted
+//
+// The number of copies can double with every card, so they are counted with big.Int
+func ProcessInput(fileScanner *bufio.Scanner) (answer.Answer, error) {
+	cards := make([]*scratchCardData, 0)
 	lineNumber := 0
 	for fileScanner.Scan() {
-		line := fileScanner.Text()
-		cardData := createScratchCard(line, cardCopiesArray[lineNumber])
+		lineNumber += 1
+		cardData, err := createScratchCard(fileScanner.Text(), lineNumber, 1)
+		if err != nil {
+			return answer.Answer{}, err
+		}
+		cards = append(cards, cardData)
+	}
+
+	result := new(big.Int)
+	for cardIndex, cardData := range cards {
 		log.Debug().
 			Int("CardID", cardData.CardID).
 			Int("CardScore", cardData.Score).
-			Int("CardCopies", cardData.Copies).
+			Stringer("CardCopies", cardData.Copies).
 			Send()
-		for i := 1; i <= cardData.Score; i++ {
-			cardCopiesArray[lineNumber+i] += cardData.Copies
+		for i := cardIndex + 1; i <= cardIndex+cardData.Score && i < len(cards); i++ {
+			cards[i].Copies.Add(cards[i].Copies, cardData.Copies)
 		}
-		lineNumber += 1
-	}
-
-	result := 0
-	for i := range cardCopiesArray {
-		result += cardCopiesArray[i]
+		result.Add(result, cardData.Copies)
 	}
-	return result, nil
+	return answer.BigInt(result), nil
 }
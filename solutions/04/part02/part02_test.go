@@ -0,0 +1,45 @@
+package part02
+
+import (
+	"hmcalister/aocCommon/golden"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func TestProcessInput(t *testing.T) {
+	golden.TestSolver(t, ProcessInput)
+}
+
+func BenchmarkProcessInput(b *testing.B) {
+	golden.BenchmarkSolver(b, ProcessInput)
+}
+
+// Seeded with the numbers of each card in the example, as the card number is parsed separately
+func FuzzStringToIntArray(f *testing.F) {
+	defer zerolog.SetGlobalLevel(zerolog.GlobalLevel())
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+
+	lines, err := golden.ExampleLines(golden.TESTDATA_DIR)
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, line := range lines {
+		_, numbers, _ := strings.Cut(line, ":")
+		for _, numberList := range strings.Split(numbers, "|") {
+			f.Add(numberList)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, numberList string) {
+		parsedInts, err := stringToIntArray(numberList, 1, 1)
+		if err != nil {
+			golden.CheckParseError(t, numberList, err)
+			return
+		}
+		if len(parsedInts) != len(strings.Fields(numberList)) {
+			t.Errorf("parsed %q to %v numbers", numberList, len(parsedInts))
+		}
+	})
+}
@@ -4,15 +4,13 @@ go 1.21.0
 
 require (
 	github.com/rs/zerolog v1.31.0
-	github.com/schollz/progressbar/v3 v3.14.1
-	golang.org/x/sync v0.5.0
+	hmcalister/aocCommon v0.0.0
 )
 
 require (
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
-	github.com/mitchellh/colorstring v0.0.0-20190213212951-d06e56a500db // indirect
-	github.com/rivo/uniseg v0.4.4 // indirect
 	golang.org/x/sys v0.14.0 // indirect
-	golang.org/x/term v0.14.0 // indirect
 )
+
+replace hmcalister/aocCommon => ../../common
@@ -1,7 +1,8 @@
 package lib
 
 import (
-	"bufio"
+	"hmcalister/aocCommon/input"
+	"hmcalister/aocCommon/interval"
 	"math"
 	"slices"
 	"sort"
@@ -9,6 +10,8 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+const DAY = 5
+
 type DomainMapper struct {
 	maps []mapData
 }
@@ -16,9 +19,8 @@ type DomainMapper struct {
 func GetIdentityMapper() DomainMapper {
 	return DomainMapper{
 		maps: []mapData{{
-			sourceStart: 0,
-			sourceEnd:   math.MaxInt,
-			offset:      0,
+			source: interval.New(0, math.MaxInt),
+			offset: 0,
 		}},
 	}
 }
@@ -46,48 +48,47 @@ func (dm DomainMapper) InverseMapValue(value int) int {
 func (dm DomainMapper) GetAllRangeStarts() []int {
 	rangeStarts := make([]int, len(dm.maps))
 	for i, m := range dm.maps {
-		rangeStarts[i] = m.sourceStart
+		rangeStarts[i] = m.source.Start
 	}
 	return rangeStarts
 }
 
-func ParseSectionToDomainMapper(fileScanner *bufio.Scanner) DomainMapper {
-	line := fileScanner.Text()
+// Parse a map section, a header line followed by a line for each mapping
+func ParseBlockToDomainMapper(block input.Block) (DomainMapper, error) {
 	log.Debug().
-		Str("DomainMapperID", line).
+		Str("DomainMapperID", block.Lines[0]).
 		Send()
 
 	maps := make([]mapData, 0)
-	for fileScanner.Scan() {
-		line = fileScanner.Text()
-		if len(line) == 0 {
-			break
-		}
-
+	for lineIndex, line := range block.Lines[1:] {
 		log.Trace().
 			Str("ParsingLineToMap", line).
 			Send()
 
-		maps = append(maps, parseLineToMapping(line))
+		mapping, err := parseLineToMapping(line, block.FirstLineNumber+1+lineIndex)
+		if err != nil {
+			return DomainMapper{}, err
+		}
+		maps = append(maps, mapping)
 	}
 
 	sort.Slice(maps, func(i, j int) bool {
-		return maps[i].sourceStart < maps[j].sourceStart
+		return maps[i].source.Start < maps[j].source.Start
 	})
 
-	return DomainMapper{maps}
+	return DomainMapper{maps}, nil
 }
 
 func ComposeDomainMappers(domainAMapper DomainMapper, domainBMapper DomainMapper) DomainMapper {
 	composedBoundaries := make([]int, 0)
 	composedBoundaries = append(composedBoundaries, 0)
 	for _, AMap := range domainAMapper.maps {
-		composedBoundaries = append(composedBoundaries, AMap.sourceStart)
-		composedBoundaries = append(composedBoundaries, AMap.sourceEnd)
+		composedBoundaries = append(composedBoundaries, AMap.source.Start)
+		composedBoundaries = append(composedBoundaries, AMap.source.End)
 	}
 	for _, BMap := range domainBMapper.maps {
-		composedBoundaries = append(composedBoundaries, domainAMapper.InverseMapValue(BMap.sourceStart))
-		composedBoundaries = append(composedBoundaries, domainAMapper.InverseMapValue(BMap.sourceEnd))
+		composedBoundaries = append(composedBoundaries, domainAMapper.InverseMapValue(BMap.source.Start))
+		composedBoundaries = append(composedBoundaries, domainAMapper.InverseMapValue(BMap.source.End))
 	}
 	composedBoundaries = append(composedBoundaries, math.MaxInt)
 	slices.Sort(composedBoundaries)
@@ -111,13 +112,12 @@ func ComposeDomainMappers(domainAMapper DomainMapper, domainBMapper DomainMapper
 
 		if len(composedMaps) > 0 && composedMaps[len(composedMaps)-1].offset == offset {
 			log.Trace().Msgf("combining maps with same offset %v (redundant map sourceStart %v)", offset, sourceStart)
-			composedMaps[len(composedMaps)-1].sourceEnd = sourceEnd
+			composedMaps[len(composedMaps)-1].source.End = sourceEnd
 			continue
 		}
 
 		composedMaps = append(composedMaps, mapData{
-			sourceStart,
-			sourceEnd,
+			interval.New(sourceStart, sourceEnd),
 			offset,
 		})
 	}
@@ -1,27 +1,24 @@
 package lib
 
 import (
-	"strconv"
-	"strings"
-
-	"github.com/rs/zerolog/log"
+	"hmcalister/aocCommon/interval"
+	"hmcalister/aocCommon/parse"
 )
 
 // Details about a mapping from one domain to another
 //
-// Mappings that the form of a+offset if a is in [start, end)
+// Mappings that the form of a+offset if a is in the source interval
 type mapData struct {
-	sourceStart int
-	sourceEnd   int
-	offset      int
+	source interval.Interval
+	offset int
 }
 
 func (md mapData) ValueInMapSource(value int) bool {
-	return md.sourceStart <= value && value < md.sourceEnd
+	return md.source.Contains(value)
 }
 
 func (md mapData) ValueInMapDest(value int) bool {
-	return md.MapValue(md.sourceStart) <= value && value < md.MapValue(md.sourceEnd)
+	return md.source.Shift(md.offset).Contains(value)
 }
 
 func (md mapData) MapValue(value int) int {
@@ -35,30 +32,29 @@ func (md mapData) InverseMapValue(value int) int {
 // Line must be of the form of three ints separated by whitespace
 //
 // The order of the ints are destinationStart, sourceStart, RangeLength
-func parseLineToMapping(line string) mapData {
-	fields := strings.Fields(line)
+func parseLineToMapping(line string, lineNumber int) (mapData, error) {
+	fields := parse.Fields(line, 1)
+	if len(fields) != 3 {
+		return mapData{}, parse.Errorf(DAY, lineNumber, 1, line, "expected 3 fields, found %v", len(fields))
+	}
 
-	destinationStartStr := fields[0]
-	destinationStart, err := strconv.Atoi(destinationStartStr)
+	destinationStart, err := parse.Atoi(DAY, lineNumber, fields[0])
 	if err != nil {
-		log.Panic().Msgf("failed to parse destination start %v (line %v)", destinationStartStr, line)
+		return mapData{}, err
 	}
 
-	sourceStartStr := fields[1]
-	sourceStart, err := strconv.Atoi(sourceStartStr)
+	sourceStart, err := parse.Atoi(DAY, lineNumber, fields[1])
 	if err != nil {
-		log.Panic().Msgf("failed to parse source start %v (line %v)", sourceStartStr, line)
+		return mapData{}, err
 	}
 
-	rangeLenStr := fields[2]
-	rangeLen, err := strconv.Atoi(rangeLenStr)
+	rangeLen, err := parse.Atoi(DAY, lineNumber, fields[2])
 	if err != nil {
-		log.Panic().Msgf("failed to parse range length %v (line %v)", rangeLenStr, line)
+		return mapData{}, err
 	}
 
 	return mapData{
-		sourceStart: sourceStart,
-		sourceEnd:   sourceStart + rangeLen,
-		offset:      destinationStart - sourceStart,
-	}
+		source: interval.FromLength(sourceStart, rangeLen),
+		offset: destinationStart - sourceStart,
+	}, nil
 }
@@ -0,0 +1,24 @@
+package lib
+
+import (
+	"hmcalister/aocCommon/golden"
+	"testing"
+)
+
+// Seeded with every line of the example, including the seeds and map headers
+func FuzzParseLineToMapping(f *testing.F) {
+	lines, err := golden.ExampleLines("../part01/" + golden.TESTDATA_DIR)
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, line := range lines {
+		f.Add(line)
+	}
+
+	f.Fuzz(func(t *testing.T, line string) {
+		_, err := parseLineToMapping(line, 1)
+		if err != nil {
+			golden.CheckParseError(t, line, err)
+		}
+	})
+}
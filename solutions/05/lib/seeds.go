@@ -0,0 +1,44 @@
+package lib
+
+import (
+	"hmcalister/aocCommon/input"
+	"hmcalister/aocCommon/parse"
+	"strings"
+)
+
+const SEEDS_LINE_PREFIX = "seeds: "
+
+// Parse the first line of the input, of the form "seeds: 79 14 55 13", to the list of seed values
+func ParseSeedsLine(line string, lineNumber int) ([]int, error) {
+	if !strings.HasPrefix(line, SEEDS_LINE_PREFIX) {
+		return nil, parse.Errorf(DAY, lineNumber, 1, line, "expected line to start with %q", SEEDS_LINE_PREFIX)
+	}
+
+	seedFields := parse.Fields(line[len(SEEDS_LINE_PREFIX):], len(SEEDS_LINE_PREFIX)+1)
+	seedValues := make([]int, len(seedFields))
+	for i, seedField := range seedFields {
+		seedValue, err := parse.Atoi(DAY, lineNumber, seedField)
+		if err != nil {
+			return nil, err
+		}
+		seedValues[i] = seedValue
+	}
+
+	return seedValues, nil
+}
+
+// Parse the seeds from the first block of the input, which must be the seeds line alone
+func ParseSeedsBlock(blocks *input.Iterator[input.Block]) ([]int, error) {
+	if !blocks.Next() {
+		if err := blocks.Err(); err != nil {
+			return nil, err
+		}
+		return nil, parse.Errorf(DAY, 0, 0, "", "input is empty")
+	}
+
+	seedsBlock := blocks.Value()
+	if len(seedsBlock.Lines) != 1 {
+		return nil, parse.Errorf(DAY, seedsBlock.FirstLineNumber+1, 1, seedsBlock.Lines[1], "expected a blank line after the seeds line")
+	}
+	return ParseSeedsLine(seedsBlock.Lines[0], seedsBlock.FirstLineNumber)
+}
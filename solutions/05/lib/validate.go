@@ -0,0 +1,31 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/input"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+)
+
+// Check the input is a seeds line followed by blank line separated map sections.
+//
+// Only part 2 reads the seeds as pairs, so an odd number of seeds is reported when solving part 2
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+	blocks := input.Blocks(input.FromScanner(fileScanner))
+
+	if _, err := ParseSeedsBlock(blocks); err != nil {
+		report.Add(err)
+		return report.Violations
+	}
+	for blocks.Next() {
+		if _, err := ParseBlockToDomainMapper(blocks.Value()); err != nil {
+			report.Add(err)
+		}
+	}
+	if err := blocks.Err(); err != nil {
+		report.Add(err)
+	}
+
+	return report.Violations
+}
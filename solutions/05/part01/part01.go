@@ -3,37 +3,37 @@ package part01
 import (
 	"bufio"
 	"hmcalister/aoc05/lib"
+	"hmcalister/aocCommon/input"
 	"math"
-	"strconv"
-	"strings"
 
 	"github.com/rs/zerolog/log"
 )
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
+	blocks := input.Blocks(input.FromScanner(fileScanner))
+
 	// Handle seeds
-	fileScanner.Scan()
-	seedLine := fileScanner.Text()
-	seedLine = seedLine[7:]
-	seedValuesStrs := strings.Fields(seedLine)
-	seedValues := make([]int, len(seedValuesStrs))
-	for i, seedValueStr := range seedValuesStrs {
-		val, err := strconv.Atoi(seedValueStr)
-		if err != nil {
-			log.Fatal().Msgf("error parsing seed value:%v", err)
-		}
-		seedValues[i] = val
+	seedValues, err := lib.ParseSeedsBlock(blocks)
+	if err != nil {
+		return 0, err
+	}
+	for i, seedValue := range seedValues {
 		log.Debug().
 			Int("SeedValueIndex", i).
-			Str("SeedValueStr", seedValueStr).
-			Int("SeedValue", val).
+			Int("SeedValue", seedValue).
 			Send()
 	}
-	fileScanner.Scan()
 
 	allDomainMappers := lib.GetIdentityMapper()
-	for fileScanner.Scan() {
-		allDomainMappers = lib.ComposeDomainMappers(allDomainMappers, lib.ParseSectionToDomainMapper(fileScanner))
+	for blocks.Next() {
+		domainMapper, err := lib.ParseBlockToDomainMapper(blocks.Value())
+		if err != nil {
+			return 0, err
+		}
+		allDomainMappers = lib.ComposeDomainMappers(allDomainMappers, domainMapper)
+	}
+	if err := blocks.Err(); err != nil {
+		return 0, err
 	}
 
 	minSeedVal := math.MaxInt
@@ -3,44 +3,43 @@ package part02
 import (
 	"bufio"
 	"hmcalister/aoc05/lib"
+	"hmcalister/aocCommon/input"
+	"hmcalister/aocCommon/interval"
+	"hmcalister/aocCommon/parse"
 	"math"
-	"strconv"
-	"strings"
 
 	"github.com/rs/zerolog/log"
 )
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	// Handle seeds
-	fileScanner.Scan()
-	seedLine := fileScanner.Text()
-	seedLine = seedLine[7:]
-	seedValuesStrs := strings.Fields(seedLine)
-	fileScanner.Scan()
+	blocks := input.Blocks(input.FromScanner(fileScanner))
 
-	allDomainMappers := lib.GetIdentityMapper()
-	for fileScanner.Scan() {
-		allDomainMappers = lib.ComposeDomainMappers(allDomainMappers, lib.ParseSectionToDomainMapper(fileScanner))
+	// Handle seeds
+	seedValues, err := lib.ParseSeedsBlock(blocks)
+	if err != nil {
+		return 0, err
+	}
+	if len(seedValues)%2 != 0 {
+		seedsBlock := blocks.Value()
+		return 0, parse.Errorf(lib.DAY, seedsBlock.FirstLineNumber, 1, seedsBlock.Lines[0], "expected pairs of seed range start and length, found %v values", len(seedValues))
 	}
 
-	minMappedValue := math.MaxInt
-	for i := 0; i < len(seedValuesStrs); i += 2 {
-		seedValueStartStr := seedValuesStrs[i]
-		seedValueRangeStr := seedValuesStrs[i+1]
-
-		seedValueStart, err := strconv.Atoi(seedValueStartStr)
-		if err != nil {
-			log.Fatal().Msgf("error parsing seed value start:%v", err)
-		}
-		seedValueRange, err := strconv.Atoi(seedValueRangeStr)
+	allDomainMappers := lib.GetIdentityMapper()
+	for blocks.Next() {
+		domainMapper, err := lib.ParseBlockToDomainMapper(blocks.Value())
 		if err != nil {
-			log.Fatal().Msgf("error parsing seed value start:%v", err)
+			return 0, err
 		}
+		allDomainMappers = lib.ComposeDomainMappers(allDomainMappers, domainMapper)
+	}
+	if err := blocks.Err(); err != nil {
+		return 0, err
+	}
 
-		rangeValue := checkSeedRange(seedRangeData{
-			SeedRangeStart:  seedValueStart,
-			SeedRangeLength: seedValueRange,
-		}, allDomainMappers)
+	minMappedValue := math.MaxInt
+	for i := 0; i < len(seedValues); i += 2 {
+		seedRange := interval.FromLength(seedValues[i], seedValues[i+1])
+		rangeValue := checkSeedRange(seedRange, allDomainMappers)
 		if rangeValue < minMappedValue {
 			minMappedValue = rangeValue
 			log.Info().Msgf("New best location found: %v", minMappedValue)
@@ -2,22 +2,18 @@ package part02
 
 import (
 	"hmcalister/aoc05/lib"
+	"hmcalister/aocCommon/interval"
 	"math"
 
 	"github.com/rs/zerolog/log"
 )
 
-type seedRangeData struct {
-	SeedRangeStart  int
-	SeedRangeLength int
-}
-
-func checkSeedRange(seedRange seedRangeData, domainMapper lib.DomainMapper) int {
-	log.Info().Interface("CheckingSeedRange", seedRange).Send()
+func checkSeedRange(seedRange interval.Interval, domainMapper lib.DomainMapper) int {
+	log.Info().Stringer("CheckingSeedRange", seedRange).Send()
 
 	// We only need to check the beginning of each map, since the maps themselves are monotonically increasing.
 
-	currentSeedValue := seedRange.SeedRangeStart
+	currentSeedValue := seedRange.Start
 
 	// Find the map that contains the current seed
 
@@ -32,7 +28,7 @@ func checkSeedRange(seedRange seedRangeData, domainMapper lib.DomainMapper) int
 	// Now just check maps until the start is beyond our target range
 
 	minSeedVal := math.MaxInt
-	for currentSeedValue < seedRange.SeedRangeStart+seedRange.SeedRangeLength {
+	for seedRange.Contains(currentSeedValue) {
 		mappedSeedValue := domainMapper.MapValue(currentSeedValue)
 		if mappedSeedValue < minSeedVal {
 			minSeedVal = mappedSeedValue
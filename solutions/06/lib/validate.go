@@ -0,0 +1,43 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+	"strings"
+)
+
+const DAY = 6
+
+// Check the input is a line of times and a line of the same number of distances
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+	lines := validate.ReadLines(fileScanner)
+
+	if len(lines) != 2 {
+		report.Addf(0, 0, "", "found %v lines, expected a line of times and a line of distances", len(lines))
+		return report.Violations
+	}
+	numTimes := checkLabelledLine(report, lines[0], 1, "Time:")
+	numDistances := checkLabelledLine(report, lines[1], 2, "Distance:")
+	if numTimes != numDistances {
+		report.Addf(0, 0, "", "found %v times but %v distances", numTimes, numDistances)
+	}
+
+	return report.Violations
+}
+
+// Check the line is the label followed by integers, returning the number of integers
+func checkLabelledLine(report *validate.Report, line string, lineNumber int, label string) int {
+	if !strings.HasPrefix(line, label) {
+		report.Addf(lineNumber, 1, line, "expected line to start with %q", label)
+		return 0
+	}
+	fields := parse.Fields(line[len(label):], len(label)+1)
+	for _, field := range fields {
+		if _, err := parse.Atoi(DAY, lineNumber, field); err != nil {
+			report.Add(err)
+		}
+	}
+	return len(fields)
+}
@@ -2,13 +2,15 @@ package part01
 
 import (
 	"bufio"
+	"hmcalister/aocCommon/parse"
 	"math"
-	"strconv"
 	"strings"
 
 	"github.com/rs/zerolog/log"
 )
 
+const DAY = 6
+
 type raceDetails struct {
 	raceID       int
 	timeAllowed  int
@@ -32,17 +34,32 @@ func (rd raceDetails) calculateError() int {
 	return upperAlpha - lowestAlpha + 1
 }
 
-func parseDataToRaceDetails(timeStrArr []string, distanceStrArr []string) []raceDetails {
-	raceDetailsArr := make([]raceDetails, len(timeStrArr))
-	for i := 0; i < len(timeStrArr); i += 1 {
-		time, err := strconv.Atoi(timeStrArr[i])
+// Scan the next line, which must start with the given label, and return the fields following the label
+func parseLabelledLine(fileScanner *bufio.Scanner, lineNumber int, label string) ([]parse.Field, error) {
+	if !fileScanner.Scan() {
+		return nil, parse.Errorf(DAY, lineNumber, 0, "", "expected a line starting with %q", label)
+	}
+	line := fileScanner.Text()
+	if !strings.HasPrefix(line, label) {
+		return nil, parse.Errorf(DAY, lineNumber, 1, line, "expected line to start with %q", label)
+	}
+	return parse.Fields(line[len(label):], len(label)+1), nil
+}
+
+func parseDataToRaceDetails(timeFields []parse.Field, distanceFields []parse.Field) ([]raceDetails, error) {
+	if len(timeFields) != len(distanceFields) {
+		return nil, parse.Errorf(DAY, 0, 0, "", "found %v times but %v distances", len(timeFields), len(distanceFields))
+	}
+	raceDetailsArr := make([]raceDetails, len(timeFields))
+	for i := 0; i < len(timeFields); i += 1 {
+		time, err := parse.Atoi(DAY, 1, timeFields[i])
 		if err != nil {
-			log.Fatal().Msgf("failed to parse time string for raceID %v (%v)", i, timeStrArr[i])
+			return nil, err
 		}
 
-		distance, err := strconv.Atoi(distanceStrArr[i])
+		distance, err := parse.Atoi(DAY, 2, distanceFields[i])
 		if err != nil {
-			log.Fatal().Msgf("failed to parse distance string for raceID %v (%v)", i, distanceStrArr[i])
+			return nil, err
 		}
 
 		raceDetailsArr[i] = raceDetails{
@@ -58,25 +75,27 @@ func parseDataToRaceDetails(timeStrArr []string, distanceStrArr []string) []race
 
 	}
 
-	return raceDetailsArr
+	return raceDetailsArr, nil
 }
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	var line string
 	// Handle the Time line
-	fileScanner.Scan()
-	line = fileScanner.Text()
-	line = strings.TrimSpace(line[5:])
-	timeStrings := strings.Fields(line)
+	timeFields, err := parseLabelledLine(fileScanner, 1, "Time:")
+	if err != nil {
+		return 0, err
+	}
 
 	// Handle the distances line
-	fileScanner.Scan()
-	line = fileScanner.Text()
-	line = strings.TrimSpace(line[9:])
-	distanceStrings := strings.Fields(line)
+	distanceFields, err := parseLabelledLine(fileScanner, 2, "Distance:")
+	if err != nil {
+		return 0, err
+	}
 
 	result := 1
-	raceDetailsArray := parseDataToRaceDetails(timeStrings, distanceStrings)
+	raceDetailsArray, err := parseDataToRaceDetails(timeFields, distanceFields)
+	if err != nil {
+		return 0, err
+	}
 	for _, rd := range raceDetailsArray {
 		e := rd.calculateError()
 		log.Debug().
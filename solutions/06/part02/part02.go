@@ -2,13 +2,15 @@ package part02
 
 import (
 	"bufio"
+	"hmcalister/aocCommon/parse"
 	"math"
-	"strconv"
 	"strings"
 
 	"github.com/rs/zerolog/log"
 )
 
+const DAY = 6
+
 type raceDetails struct {
 	raceID       int
 	timeAllowed  int
@@ -32,38 +34,37 @@ func (rd raceDetails) calculateError() int {
 	return upperAlpha - lowestAlpha + 1
 }
 
-func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	var line string
-	// Handle the Time line
-	fileScanner.Scan()
-	line = fileScanner.Text()
-	line = strings.TrimSpace(line[5:])
-	timeString := strings.Join(strings.Fields(line), "")
+// Scan the next line, which must start with the given label, and parse the digits following the label
+// as a single number, ignoring the spaces between them
+func parseLabelledLine(fileScanner *bufio.Scanner, lineNumber int, label string) (int, error) {
+	if !fileScanner.Scan() {
+		return 0, parse.Errorf(DAY, lineNumber, 0, "", "expected a line starting with %q", label)
+	}
+	line := fileScanner.Text()
+	if !strings.HasPrefix(line, label) {
+		return 0, parse.Errorf(DAY, lineNumber, 1, line, "expected line to start with %q", label)
+	}
+	numberString := strings.Join(strings.Fields(line[len(label):]), "")
 
 	log.Debug().
 		Str("Line", line).
-		Str("TimeString", timeString).
+		Str("NumberString", numberString).
 		Send()
 
-	// Handle the distances line
-	fileScanner.Scan()
-	line = fileScanner.Text()
-	line = strings.TrimSpace(line[9:])
-	distanceString := strings.Join(strings.Fields(line), "")
-
-	log.Debug().
-		Str("Line", line).
-		Str("DistanceString", distanceString).
-		Send()
+	return parse.Atoi(DAY, lineNumber, parse.Field{Text: numberString, Column: len(label) + 1})
+}
 
-	time, err := strconv.Atoi(timeString)
+func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
+	// Handle the Time line
+	time, err := parseLabelledLine(fileScanner, 1, "Time:")
 	if err != nil {
-		log.Fatal().Msgf("failed to parse time string (%v)", timeString)
+		return 0, err
 	}
 
-	distance, err := strconv.Atoi(distanceString)
+	// Handle the distances line
+	distance, err := parseLabelledLine(fileScanner, 2, "Distance:")
 	if err != nil {
-		log.Fatal().Msgf("failed to parse distance string (%v)", distanceString)
+		return 0, err
 	}
 
 	rd := raceDetails{
@@ -0,0 +1,28 @@
+package lib
+
+import (
+	"hmcalister/aocCommon/golden"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func FuzzParseLineToHandData(f *testing.F) {
+	defer zerolog.SetGlobalLevel(zerolog.GlobalLevel())
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+
+	lines, err := golden.ExampleLines("../" + golden.TESTDATA_DIR)
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, line := range lines {
+		f.Add(line)
+	}
+
+	f.Fuzz(func(t *testing.T, line string) {
+		_, err := ParseLineToHandData(line, 1)
+		if err != nil {
+			golden.CheckParseError(t, line, err)
+		}
+	})
+}
@@ -0,0 +1,25 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+)
+
+// Check each line is a hand of cards and a bid. Jokers are written as J, so the cards are the same for both parts
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+
+	lineNumber := 0
+	for fileScanner.Scan() {
+		lineNumber += 1
+		if _, err := ParseLineToHandData(fileScanner.Text(), lineNumber); err != nil {
+			report.Add(err)
+		}
+	}
+	if lineNumber == 0 {
+		report.Addf(0, 0, "", "input is empty")
+	}
+
+	return report.Violations
+}
@@ -10,9 +10,15 @@ import (
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 	allHands := make([]lib.HandData, 0)
+	lineNumber := 0
 	for fileScanner.Scan() {
+		lineNumber += 1
 		line := fileScanner.Text()
-		allHands = append(allHands, lib.ParseLineToHandData(line))
+		hand, err := lib.ParseLineToHandData(line, lineNumber)
+		if err != nil {
+			return 0, err
+		}
+		allHands = append(allHands, hand)
 	}
 
 	log.Debug().
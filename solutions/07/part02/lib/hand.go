@@ -1,8 +1,8 @@
 package lib
 
 import (
+	"hmcalister/aocCommon/parse"
 	"math"
-	"strconv"
 	"strings"
 
 	"github.com/rs/zerolog/log"
@@ -11,6 +11,12 @@ import (
 //go:generate stringer -type=HandTypeEnum
 type HandTypeEnum int
 
+const (
+	DAY = 7
+
+	HAND_SIZE = 5
+)
+
 const (
 	CARD_STRENGTH = "J23456789TQKA"
 
@@ -107,23 +113,31 @@ func calculateHandPartialStrength(cardStrengths []int) float64 {
 	return partialStrength
 }
 
-func ParseLineToHandData(line string) HandData {
-	fields := strings.Fields(line)
+// Parse a line of the form "32T3K 765", being the cards of the hand then the bid amount
+func ParseLineToHandData(line string, lineNumber int) (HandData, error) {
+	fields := parse.Fields(line, 1)
 	log.Debug().
 		Str("ParsingLine", line).
 		Int("NumFields", len(fields)).
 		Send()
+	if len(fields) != 2 {
+		return HandData{}, parse.Errorf(DAY, lineNumber, 1, line, "expected cards and bid amount, found %v fields", len(fields))
+	}
+
+	if len(fields[0].Text) != HAND_SIZE {
+		return HandData{}, parse.Errorf(DAY, lineNumber, fields[0].Column, fields[0].Text, "expected %v cards, found %v", HAND_SIZE, len(fields[0].Text))
+	}
 
-	bidAmount, err := strconv.Atoi(fields[1])
+	bidAmount, err := parse.Atoi(DAY, lineNumber, fields[1])
 	if err != nil {
-		log.Panic().Msgf("failed to parse bid amount %v in line %v", fields[1], line)
+		return HandData{}, err
 	}
 
-	cardStrengths := make([]int, len(fields[0]))
-	for i, currentCard := range fields[0] {
+	cardStrengths := make([]int, len(fields[0].Text))
+	for i, currentCard := range fields[0].Text {
 		cardStrength := strings.IndexRune(CARD_STRENGTH, currentCard)
 		if cardStrength == -1 {
-			log.Panic().Msgf("encountered unknown card value %v in line %v", currentCard, line)
+			return HandData{}, parse.Errorf(DAY, lineNumber, fields[0].Column+i, string(currentCard), "unknown card value")
 		}
 		cardStrengths[i] = cardStrength
 	}
@@ -143,5 +157,5 @@ func ParseLineToHandData(line string) HandData {
 		Str("HandType", parsedHandData.HandType.String()).
 		Send()
 
-	return parsedHandData
+	return parsedHandData, nil
 }
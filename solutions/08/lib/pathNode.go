@@ -0,0 +1,25 @@
+package lib
+
+import "hmcalister/aocCommon/parse"
+
+// The labels of a node line, like the example: BKM = (CDC, PSH)
+type PathNodeLine struct {
+	Label          string
+	LeftNodeLabel  string
+	RightNodeLabel string
+}
+
+// Parse a node line, which both parts slice at fixed offsets
+func ParsePathNodeLine(line string, lineNumber int) (PathNodeLine, error) {
+	if !pathNodeLineRegexp.MatchString(line) {
+		return PathNodeLine{}, parse.Errorf(DAY, lineNumber, 1, line, "expected node line of the form \"BKM = (CDC, PSH)\"")
+	}
+
+	// Labels are always exactly three letters long.
+	// The left label exists from indices 7 to 10, and the right 12 to 15
+	return PathNodeLine{
+		Label:          line[0:3],
+		LeftNodeLabel:  line[7:10],
+		RightNodeLabel: line[12:15],
+	}, nil
+}
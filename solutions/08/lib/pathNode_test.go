@@ -0,0 +1,31 @@
+package lib
+
+import (
+	"fmt"
+	"hmcalister/aocCommon/golden"
+	"testing"
+)
+
+func FuzzParsePathNodeLine(f *testing.F) {
+	for _, dir := range []string{"../part01/" + golden.TESTDATA_DIR, "../part02/" + golden.TESTDATA_DIR} {
+		lines, err := golden.ExampleLines(dir)
+		if err != nil {
+			f.Fatal(err)
+		}
+		for _, line := range lines {
+			f.Add(line)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, line string) {
+		nodeLine, err := ParsePathNodeLine(line, 1)
+		if err != nil {
+			golden.CheckParseError(t, line, err)
+			return
+		}
+		printed := fmt.Sprintf("%v = (%v, %v)", nodeLine.Label, nodeLine.LeftNodeLabel, nodeLine.RightNodeLabel)
+		if printed != line {
+			t.Errorf("parsed %q to node printed as %q", line, printed)
+		}
+	})
+}
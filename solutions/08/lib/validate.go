@@ -0,0 +1,72 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+	"regexp"
+)
+
+const (
+	DAY = 8
+
+	START_LABEL    = "AAA"
+	TERMINAL_LABEL = "ZZZ"
+)
+
+// Both parts slice node lines at fixed offsets, so labels must be exactly three characters
+// and the separators must be exactly as in "BKM = (CDC, PSH)"
+var pathNodeLineRegexp = regexp.MustCompile(`^[0-9A-Z]{3} = \([0-9A-Z]{3}, [0-9A-Z]{3}\)$`)
+
+// Check the directions line contains only L and R, followed by a blank line and then
+// fixed width node lines. Every referenced label must be defined exactly once.
+//
+// Part 1 alone needs the start and terminal labels, so their absence is reported when solving part 1 instead
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+	lines := validate.ReadLines(fileScanner)
+
+	if len(lines) < 3 {
+		report.Addf(0, 0, "", "found %v lines, expected a directions line, a blank line, and at least one node", len(lines))
+		return report.Violations
+	}
+	if len(lines[0]) == 0 {
+		report.Addf(1, 0, lines[0], "directions line is empty")
+	}
+	report.CheckRunes(lines[0], 1, "LR")
+	if len(lines[1]) != 0 {
+		report.Addf(2, 1, lines[1], "expected blank line after directions")
+	}
+
+	// Map from each defined label to the line it is defined on
+	definedLabels := make(map[string]int)
+	for lineIndex := 2; lineIndex < len(lines); lineIndex += 1 {
+		line := lines[lineIndex]
+		nodeLine, err := ParsePathNodeLine(line, lineIndex+1)
+		if err != nil {
+			report.Add(err)
+			continue
+		}
+		label := nodeLine.Label
+		if definedOn, ok := definedLabels[label]; ok {
+			report.Addf(lineIndex+1, 1, line, "node %v already defined on line %v", label, definedOn)
+			continue
+		}
+		definedLabels[label] = lineIndex + 1
+	}
+
+	for lineIndex := 2; lineIndex < len(lines); lineIndex += 1 {
+		line := lines[lineIndex]
+		if !pathNodeLineRegexp.MatchString(line) {
+			continue
+		}
+		for _, labelStart := range []int{7, 12} {
+			label := line[labelStart : labelStart+3]
+			if _, ok := definedLabels[label]; !ok {
+				report.Addf(lineIndex+1, labelStart+1, line, "node %v is never defined", label)
+			}
+		}
+	}
+
+	return report.Violations
+}
@@ -2,15 +2,12 @@ package part01
 
 import (
 	"bufio"
+	"hmcalister/aoc08/lib"
+	"hmcalister/aocCommon/parse"
 
 	"github.com/rs/zerolog/log"
 )
 
-const (
-	START_LABEL    = "AAA"
-	TERMINAL_LABEL = "ZZZ"
-)
-
 type pathNodeData struct {
 	Label          string
 	LeftNodeLabel  string
@@ -31,22 +28,20 @@ func createPath() *pathData {
 	return path
 }
 
-// Create and return a new path node data struct,
-// as well as insert it into the pathNodeMap attribute
-func (path *pathData) parseLineToPathNodeData(line string) {
-	// A line is structured like the example: BKM = (CDC, PSH)
-	// Labels are always exactly three letters long
-	pathNodeLabel := line[0:3]
-
-	// The left label exists from indices 7 to 10, and the right 12 to 15
-	leftNodeLabel := line[7:10]
-	rightNodeLabel := line[12:15]
+// Create a new path node data struct from a line like the example: BKM = (CDC, PSH),
+// and insert it into the pathNodeMap attribute
+func (path *pathData) parseLineToPathNodeData(line string, lineNumber int) error {
+	nodeLine, err := lib.ParsePathNodeLine(line, lineNumber)
+	if err != nil {
+		return err
+	}
+	pathNodeLabel := nodeLine.Label
 
 	newPathNode := &pathNodeData{
 		Label:          pathNodeLabel,
-		LeftNodeLabel:  leftNodeLabel,
-		RightNodeLabel: rightNodeLabel,
-		IsTerminal:     pathNodeLabel == TERMINAL_LABEL,
+		LeftNodeLabel:  nodeLine.LeftNodeLabel,
+		RightNodeLabel: nodeLine.RightNodeLabel,
+		IsTerminal:     pathNodeLabel == lib.TERMINAL_LABEL,
 	}
 
 	log.Trace().
@@ -57,6 +52,7 @@ func (path *pathData) parseLineToPathNodeData(line string) {
 	// Add the node to the node map and array
 	path.allPathNodes = append(path.allPathNodes, newPathNode)
 	path.pathNodeMap[pathNodeLabel] = newPathNode
+	return nil
 }
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
@@ -71,18 +67,30 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 		} else if r == 'R' {
 			directionsArray[i] = DIRECTION_RIGHT
 		} else {
-			log.Panic().Msgf("encountered unknown rune while parsing directions line at index %v: %v", i, r)
+			return 0, parse.Errorf(lib.DAY, 1, i+1, directionsLine, "unknown direction %q", r)
 		}
 	}
 
 	path := createPath()
 
+	// Node lines start after the directions line and a blank line
 	fileScanner.Scan()
+	lineNumber := 2
 	for fileScanner.Scan() {
-		path.parseLineToPathNodeData(fileScanner.Text())
+		lineNumber += 1
+		if err := path.parseLineToPathNodeData(fileScanner.Text(), lineNumber); err != nil {
+			return 0, err
+		}
+	}
+
+	// Only part 1 walks from AAA to ZZZ, so the validator leaves these for us to check
+	for _, label := range []string{lib.START_LABEL, lib.TERMINAL_LABEL} {
+		if _, ok := path.pathNodeMap[label]; !ok {
+			return 0, parse.Errorf(lib.DAY, 0, 0, "", "node %v is never defined", label)
+		}
 	}
 
-	currentPathNode := path.pathNodeMap[START_LABEL]
+	currentPathNode := path.pathNodeMap[lib.START_LABEL]
 	numSteps := 0
 	for {
 		if currentPathNode.IsTerminal {
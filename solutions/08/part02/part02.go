@@ -2,6 +2,11 @@ package part02
 
 import (
 	"bufio"
+	"errors"
+	"hmcalister/aoc08/lib"
+	"hmcalister/aocCommon/answer"
+	"hmcalister/aocCommon/aocmath"
+	"hmcalister/aocCommon/parse"
 	"strings"
 
 	"github.com/rs/zerolog/log"
@@ -32,21 +37,19 @@ func createPath() *pathData {
 	return path
 }
 
-// Create and return a new path node data struct,
-// as well as insert it into the pathNodeMap attribute
-func (path *pathData) parseLineToPathNodeData(line string) {
-	// A line is structured like the example: BKM = (CDC, PSH)
-	// Labels are always exactly three letters long
-	pathNodeLabel := line[0:3]
-
-	// The left label exists from indices 7 to 10, and the right 12 to 15
-	leftNodeLabel := line[7:10]
-	rightNodeLabel := line[12:15]
+// Create a new path node data struct from a line like the example: BKM = (CDC, PSH),
+// and insert it into the pathNodeMap attribute
+func (path *pathData) parseLineToPathNodeData(line string, lineNumber int) error {
+	nodeLine, err := lib.ParsePathNodeLine(line, lineNumber)
+	if err != nil {
+		return err
+	}
+	pathNodeLabel := nodeLine.Label
 
 	newPathNode := &pathNodeData{
 		Label:          pathNodeLabel,
-		LeftNodeLabel:  leftNodeLabel,
-		RightNodeLabel: rightNodeLabel,
+		LeftNodeLabel:  nodeLine.LeftNodeLabel,
+		RightNodeLabel: nodeLine.RightNodeLabel,
 		IsTerminal:     strings.HasSuffix(pathNodeLabel, TERMINAL_LABEL_SUFFIX),
 	}
 
@@ -60,30 +63,10 @@ func (path *pathData) parseLineToPathNodeData(line string) {
 		path.allStartPathNodes = append(path.allStartPathNodes, newPathNode)
 	}
 	path.pathNodeMap[pathNodeLabel] = newPathNode
+	return nil
 }
 
-// greatest common divisor (GCD) via Euclidean algorithm
-func gcd(a, b int) int {
-	for b != 0 {
-		t := b
-		b = a % b
-		a = t
-	}
-	return a
-}
-
-// find Least Common Multiple (LCM) via GCD
-func lcm(a, b int, integers ...int) int {
-	result := a * b / gcd(a, b)
-
-	for i := 0; i < len(integers); i++ {
-		result = lcm(result, integers[i])
-	}
-
-	return result
-}
-
-func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
+func ProcessInput(fileScanner *bufio.Scanner) (answer.Answer, error) {
 	fileScanner.Scan()
 	directionsLine := fileScanner.Text()
 	log.Trace().Str("DirectionsLine", directionsLine).Send()
@@ -95,15 +78,20 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 		} else if r == 'R' {
 			directionsArray[i] = DIRECTION_RIGHT
 		} else {
-			log.Panic().Msgf("encountered unknown rune while parsing directions line at index %v: %v", i, r)
+			return answer.Answer{}, parse.Errorf(lib.DAY, 1, i+1, directionsLine, "unknown direction %q", r)
 		}
 	}
 	log.Info().Int("DirectionArrayLength", len(directionsArray)).Send()
 
 	path := createPath()
+	// Node lines start after the directions line and a blank line
 	fileScanner.Scan()
+	lineNumber := 2
 	for fileScanner.Scan() {
-		path.parseLineToPathNodeData(fileScanner.Text())
+		lineNumber += 1
+		if err := path.parseLineToPathNodeData(fileScanner.Text(), lineNumber); err != nil {
+			return answer.Answer{}, err
+		}
 	}
 
 	allStartNodes := make([]*pathNodeData, len(path.allStartPathNodes))
@@ -113,7 +101,7 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 		Interface("StartNodes", allStartNodes).
 		Send()
 
-	cumulativeLCM := 1
+	cycleLengths := make([]int, 0, len(allStartNodes))
 	var nextDirection directionEnum
 	var currentNode *pathNodeData
 	var nextNode *pathNodeData
@@ -144,15 +132,22 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 			step += 1
 		}
 
-		cumulativeLCM = lcm(cumulativeLCM, step)
+		cycleLengths = append(cycleLengths, step)
 		log.Info().
 			Int("StartNodeIndex", startNodeIndex).
 			Interface("StartNode", startNode).
 			Interface("TerminalNode", currentNode).
 			Int("NumSteps", step).
-			Int("CumulativeLCM", cumulativeLCM).
 			Send()
 	}
 
-	return cumulativeLCM, nil
+	// The LCM of many cycle lengths can easily overflow an int
+	cycleLCM, err := aocmath.LCMOfSlice(cycleLengths)
+	if errors.Is(err, aocmath.ErrOverflow) {
+		return answer.BigInt(aocmath.BigLCMOfSlice(cycleLengths)), nil
+	}
+	if err != nil {
+		return answer.Answer{}, err
+	}
+	return answer.Int(cycleLCM), nil
 }
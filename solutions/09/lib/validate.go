@@ -0,0 +1,32 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+)
+
+const DAY = 9
+
+// Check each line is a non-empty history of integers
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+	lines := validate.ReadLines(fileScanner)
+
+	if len(lines) == 0 {
+		report.Addf(0, 0, "", "input is empty")
+	}
+	for lineIndex, line := range lines {
+		fields := parse.Fields(line, 1)
+		if len(fields) == 0 {
+			report.Addf(lineIndex+1, 0, line, "expected at least one history value")
+		}
+		for _, field := range fields {
+			if _, err := parse.Atoi(DAY, lineIndex+1, field); err != nil {
+				report.Add(err)
+			}
+		}
+	}
+
+	return report.Violations
+}
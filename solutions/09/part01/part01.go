@@ -2,32 +2,36 @@ package part01
 
 import (
 	"bufio"
+	"hmcalister/aocCommon/parse"
 	"slices"
-	"strconv"
-	"strings"
 
 	"github.com/rs/zerolog/log"
 )
 
+const DAY = 9
+
 type historyData struct {
 	HistoricValues []int
 }
 
-func parseLineToHistoryData(line string) historyData {
-	fields := strings.Fields(line)
+func parseLineToHistoryData(line string, lineNumber int) (historyData, error) {
+	fields := parse.Fields(line, 1)
+	if len(fields) == 0 {
+		return historyData{}, parse.Errorf(DAY, lineNumber, 0, line, "expected at least one history value")
+	}
 	history := historyData{
 		HistoricValues: make([]int, len(fields)),
 	}
 
 	var err error
 	for i, f := range fields {
-		history.HistoricValues[i], err = strconv.Atoi(f)
+		history.HistoricValues[i], err = parse.Atoi(DAY, lineNumber, f)
 		if err != nil {
-			log.Panic().Msgf("failed to parse history value %v at index %v in line %v", f, i, line)
+			return historyData{}, err
 		}
 	}
 
-	return history
+	return history, nil
 }
 
 func (history historyData) findNextValueInSequence() int {
@@ -78,10 +82,15 @@ func (history historyData) findNextValueInSequence() int {
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 	result := 0
+	lineNumber := 0
 
 	for fileScanner.Scan() {
+		lineNumber += 1
 		line := fileScanner.Text()
-		history := parseLineToHistoryData(line)
+		history, err := parseLineToHistoryData(line, lineNumber)
+		if err != nil {
+			return 0, err
+		}
 		log.Debug().
 			Str("ParsedLine", line).
 			Interface("ParsedHistory", history).
@@ -0,0 +1,32 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+	"strings"
+)
+
+const (
+	DAY = 10
+
+	START_RUNE = 'S'
+)
+
+// Check the maze is rectangular and made of pipes and ground, with exactly one start
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+	lines := validate.ReadLines(fileScanner)
+
+	report.CheckUniformWidth(lines)
+	numStarts := 0
+	for lineIndex, line := range lines {
+		report.CheckRunes(line, lineIndex+1, "|-LJ7F."+string(START_RUNE))
+		numStarts += strings.Count(line, string(START_RUNE))
+	}
+	if numStarts != 1 {
+		report.Addf(0, 0, "", "found %v starts, expected exactly 1", numStarts)
+	}
+
+	return report.Violations
+}
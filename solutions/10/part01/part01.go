@@ -2,146 +2,110 @@ package part01
 
 import (
 	"bufio"
+	"errors"
+	"fmt"
+	"hmcalister/aocCommon/grid"
 	"strings"
 
 	"github.com/rs/zerolog/log"
 )
 
-//go:generate stringer -type=directionEnum
-type directionEnum int
-
 const (
+	DAY             = 10
 	START_RUNE rune = 'S'
-
-	DIRECTION_NORTH directionEnum = iota
-	DIRECTION_EAST  directionEnum = iota
-	DIRECTION_SOUTH directionEnum = iota
-	DIRECTION_WEST  directionEnum = iota
-)
-
-var (
-	PipeMaze [][]rune
-
-	NorthwardsRunes map[rune]directionEnum
-	EastwardsRunes  map[rune]directionEnum
-	SouthwardsRunes map[rune]directionEnum
-	WestwardsRunes  map[rune]directionEnum
-	DirectionMap    map[directionEnum]map[rune]directionEnum
 )
 
 type NodeData struct {
-	XCoordinate int
-	YCoordinate int
-	NodeRune    rune
+	Coordinate grid.Point
+	NodeRune   rune
 }
 
-func (node NodeData) nextNode(direction directionEnum) NodeData {
-	var nextXCoord int
-	var nextYCoord int
-	switch direction {
-	case DIRECTION_NORTH:
-		nextXCoord, nextYCoord = node.XCoordinate, node.YCoordinate-1
-	case DIRECTION_EAST:
-		nextXCoord, nextYCoord = node.XCoordinate+1, node.YCoordinate
-	case DIRECTION_SOUTH:
-		nextXCoord, nextYCoord = node.XCoordinate, node.YCoordinate+1
-	case DIRECTION_WEST:
-		nextXCoord, nextYCoord = node.XCoordinate-1, node.YCoordinate
-	}
+// Step to the next node in the given direction. Nodes outside the maze have a zero rune.
+func (node NodeData) nextNode(pipeMaze *grid.Grid[rune], direction grid.DirectionEnum) NodeData {
+	nextCoord := node.Coordinate.Move(direction)
+	nextRune, _ := pipeMaze.Lookup(nextCoord)
 
 	nextNode := NodeData{
-		XCoordinate: nextXCoord,
-		YCoordinate: nextYCoord,
-		NodeRune:    PipeMaze[nextYCoord][nextXCoord],
+		Coordinate: nextCoord,
+		NodeRune:   nextRune,
 	}
 
 	return nextNode
 }
 
-func determineStartDirection(startNode NodeData) directionEnum {
+func determineStartDirection(pipeMaze *grid.Grid[rune], startNode NodeData) grid.DirectionEnum {
 	var nextNode NodeData
-	nextNode = startNode.nextNode(DIRECTION_NORTH)
+	nextNode = startNode.nextNode(pipeMaze, grid.DIRECTION_UP)
 	if strings.ContainsRune("|7F", nextNode.NodeRune) {
-		return DIRECTION_NORTH
+		return grid.DIRECTION_UP
 	}
 
-	nextNode = startNode.nextNode(DIRECTION_EAST)
+	nextNode = startNode.nextNode(pipeMaze, grid.DIRECTION_RIGHT)
 	if strings.ContainsRune("-J7", nextNode.NodeRune) {
-		return DIRECTION_EAST
+		return grid.DIRECTION_RIGHT
 	}
 
-	nextNode = startNode.nextNode(DIRECTION_SOUTH)
+	nextNode = startNode.nextNode(pipeMaze, grid.DIRECTION_DOWN)
 	if strings.ContainsRune("|LJ", nextNode.NodeRune) {
-		return DIRECTION_SOUTH
+		return grid.DIRECTION_DOWN
 	}
 
-	return DIRECTION_WEST
+	return grid.DIRECTION_LEFT
 }
 
 type LoopData struct {
-	StartXCoordinate int
-	StartYCoordinate int
-	LoopNodes        []NodeData
+	StartCoordinate grid.Point
+	LoopNodes       []NodeData
 }
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	NorthwardsRunes = map[rune]directionEnum{
-		'|': DIRECTION_NORTH,
-		'7': DIRECTION_WEST,
-		'F': DIRECTION_EAST,
+	northwardsRunes := map[rune]grid.DirectionEnum{
+		'|': grid.DIRECTION_UP,
+		'7': grid.DIRECTION_LEFT,
+		'F': grid.DIRECTION_RIGHT,
 	}
-	EastwardsRunes = map[rune]directionEnum{
-		'-': DIRECTION_EAST,
-		'7': DIRECTION_SOUTH,
-		'J': DIRECTION_NORTH,
+	eastwardsRunes := map[rune]grid.DirectionEnum{
+		'-': grid.DIRECTION_RIGHT,
+		'7': grid.DIRECTION_DOWN,
+		'J': grid.DIRECTION_UP,
 	}
-	SouthwardsRunes = map[rune]directionEnum{
-		'|': DIRECTION_SOUTH,
-		'L': DIRECTION_EAST,
-		'J': DIRECTION_WEST,
+	southwardsRunes := map[rune]grid.DirectionEnum{
+		'|': grid.DIRECTION_DOWN,
+		'L': grid.DIRECTION_RIGHT,
+		'J': grid.DIRECTION_LEFT,
 	}
-	WestwardsRunes = map[rune]directionEnum{
-		'-': DIRECTION_WEST,
-		'L': DIRECTION_NORTH,
-		'F': DIRECTION_SOUTH,
+	westwardsRunes := map[rune]grid.DirectionEnum{
+		'-': grid.DIRECTION_LEFT,
+		'L': grid.DIRECTION_UP,
+		'F': grid.DIRECTION_DOWN,
 	}
 
-	DirectionMap = map[directionEnum]map[rune]directionEnum{
-		DIRECTION_NORTH: NorthwardsRunes,
-		DIRECTION_EAST:  EastwardsRunes,
-		DIRECTION_SOUTH: SouthwardsRunes,
-		DIRECTION_WEST:  WestwardsRunes,
+	directionMap := map[grid.DirectionEnum]map[rune]grid.DirectionEnum{
+		grid.DIRECTION_UP:    northwardsRunes,
+		grid.DIRECTION_RIGHT: eastwardsRunes,
+		grid.DIRECTION_DOWN:  southwardsRunes,
+		grid.DIRECTION_LEFT:  westwardsRunes,
 	}
 
-	PipeMaze = make([][]rune, 0)
-	for fileScanner.Scan() {
-		line := fileScanner.Text()
-		PipeMaze = append(PipeMaze, []rune(line))
-		log.Debug().
-			Int("YCoord", len(PipeMaze)).
-			Str("Line", line).
-			Send()
+	pipeMaze, err := grid.ParseRunes(DAY, fileScanner)
+	if err != nil {
+		return 0, err
 	}
 
-	var startNode NodeData
-	for yCoord, row := range PipeMaze {
-		for xCoord, col := range row {
-			if col == START_RUNE {
-				startNode = NodeData{
-					XCoordinate: xCoord,
-					YCoordinate: yCoord,
-					NodeRune:    START_RUNE,
-				}
-			}
-		}
+	startCoordinates := pipeMaze.Find(func(r rune) bool { return r == START_RUNE })
+	if len(startCoordinates) != 1 {
+		return 0, errors.New("expected exactly one start rune in pipe maze")
+	}
+	startNode := NodeData{
+		Coordinate: startCoordinates[0],
+		NodeRune:   START_RUNE,
 	}
 	loop := LoopData{
-		StartXCoordinate: startNode.XCoordinate,
-		StartYCoordinate: startNode.YCoordinate,
-		LoopNodes:        []NodeData{startNode},
+		StartCoordinate: startNode.Coordinate,
+		LoopNodes:       []NodeData{startNode},
 	}
 
-	direction := determineStartDirection(startNode)
+	direction := determineStartDirection(pipeMaze, startNode)
 	currentNode := startNode
 	log.Debug().
 		Interface("StartNode", currentNode).
@@ -150,8 +114,9 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 		Send()
 
 	for {
-		currentNode = currentNode.nextNode(direction)
-		direction = DirectionMap[direction][currentNode.NodeRune]
+		currentNode = currentNode.nextNode(pipeMaze, direction)
+		nextDirection, isConnected := directionMap[direction][currentNode.NodeRune]
+		direction = nextDirection
 		log.Debug().
 			Interface("CurrentNode", currentNode).
 			Str("CurrentNodeRune", string(currentNode.NodeRune)).
@@ -161,6 +126,9 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 		if currentNode.NodeRune == START_RUNE {
 			break
 		}
+		if !isConnected {
+			return 0, fmt.Errorf("pipe loop is broken at %v", currentNode.Coordinate)
+		}
 
 		loop.LoopNodes = append(loop.LoopNodes, currentNode)
 	}
@@ -1,32 +1,34 @@
 package part02
 
-func createDirectionMap() map[directionEnum]map[rune]directionEnum {
-	NorthwardsRunes := map[rune]directionEnum{
-		'|': DIRECTION_NORTH,
-		'7': DIRECTION_WEST,
-		'F': DIRECTION_EAST,
+import "hmcalister/aocCommon/grid"
+
+func createDirectionMap() map[grid.DirectionEnum]map[rune]grid.DirectionEnum {
+	NorthwardsRunes := map[rune]grid.DirectionEnum{
+		'|': grid.DIRECTION_UP,
+		'7': grid.DIRECTION_LEFT,
+		'F': grid.DIRECTION_RIGHT,
 	}
-	EastwardsRunes := map[rune]directionEnum{
-		'-': DIRECTION_EAST,
-		'7': DIRECTION_SOUTH,
-		'J': DIRECTION_NORTH,
+	EastwardsRunes := map[rune]grid.DirectionEnum{
+		'-': grid.DIRECTION_RIGHT,
+		'7': grid.DIRECTION_DOWN,
+		'J': grid.DIRECTION_UP,
 	}
-	SouthwardsRunes := map[rune]directionEnum{
-		'|': DIRECTION_SOUTH,
-		'L': DIRECTION_EAST,
-		'J': DIRECTION_WEST,
+	SouthwardsRunes := map[rune]grid.DirectionEnum{
+		'|': grid.DIRECTION_DOWN,
+		'L': grid.DIRECTION_RIGHT,
+		'J': grid.DIRECTION_LEFT,
 	}
-	WestwardsRunes := map[rune]directionEnum{
-		'-': DIRECTION_WEST,
-		'L': DIRECTION_NORTH,
-		'F': DIRECTION_SOUTH,
+	WestwardsRunes := map[rune]grid.DirectionEnum{
+		'-': grid.DIRECTION_LEFT,
+		'L': grid.DIRECTION_UP,
+		'F': grid.DIRECTION_DOWN,
 	}
 
-	DirectionMap := map[directionEnum]map[rune]directionEnum{
-		DIRECTION_NORTH: NorthwardsRunes,
-		DIRECTION_EAST:  EastwardsRunes,
-		DIRECTION_SOUTH: SouthwardsRunes,
-		DIRECTION_WEST:  WestwardsRunes,
+	DirectionMap := map[grid.DirectionEnum]map[rune]grid.DirectionEnum{
+		grid.DIRECTION_UP:    NorthwardsRunes,
+		grid.DIRECTION_RIGHT: EastwardsRunes,
+		grid.DIRECTION_DOWN:  SouthwardsRunes,
+		grid.DIRECTION_LEFT:  WestwardsRunes,
 	}
 	return DirectionMap
 }
@@ -1,47 +1,38 @@
 package part02
 
-import "strings"
+import (
+	"hmcalister/aocCommon/grid"
+	"strings"
+)
 
 type NodeData struct {
-	XCoordinate int
-	YCoordinate int
-	NodeRune    rune
+	Coordinate grid.Point
+	NodeRune   rune
 }
 
-func (node NodeData) nextNode(direction directionEnum) NodeData {
-	var nextXCoord int
-	var nextYCoord int
-	switch direction {
-	case DIRECTION_NORTH:
-		nextXCoord, nextYCoord = node.XCoordinate, node.YCoordinate-1
-	case DIRECTION_EAST:
-		nextXCoord, nextYCoord = node.XCoordinate+1, node.YCoordinate
-	case DIRECTION_SOUTH:
-		nextXCoord, nextYCoord = node.XCoordinate, node.YCoordinate+1
-	case DIRECTION_WEST:
-		nextXCoord, nextYCoord = node.XCoordinate-1, node.YCoordinate
-	}
+// Step to the next node in the given direction. Nodes outside the maze have a zero rune.
+func (node NodeData) nextNode(pipeMaze *grid.Grid[rune], direction grid.DirectionEnum) NodeData {
+	nextCoord := node.Coordinate.Move(direction)
+	nextRune, _ := pipeMaze.Lookup(nextCoord)
 
 	nextNode := NodeData{
-		XCoordinate: nextXCoord,
-		YCoordinate: nextYCoord,
-		NodeRune:    PipeMaze[nextYCoord][nextXCoord],
+		Coordinate: nextCoord,
+		NodeRune:   nextRune,
 	}
 
 	return nextNode
 }
 
-func determineStartDirection(startNode NodeData) directionEnum {
-	nextNode := startNode.nextNode(DIRECTION_NORTH)
+func determineStartDirection(pipeMaze *grid.Grid[rune], startNode NodeData) grid.DirectionEnum {
+	nextNode := startNode.nextNode(pipeMaze, grid.DIRECTION_UP)
 	if strings.ContainsRune("|7F", nextNode.NodeRune) {
-		return DIRECTION_NORTH
+		return grid.DIRECTION_UP
 	}
-	return DIRECTION_EAST
+	return grid.DIRECTION_RIGHT
 }
 
 type LoopData struct {
-	StartXCoordinate int
-	StartYCoordinate int
-	LoopNodes        []NodeData
-	LoopDirection    []directionEnum
+	StartCoordinate grid.Point
+	LoopNodes       []NodeData
+	LoopDirection   []grid.DirectionEnum
 }
@@ -2,54 +2,42 @@ package part02
 
 import (
 	"bufio"
+	"errors"
+	"fmt"
+	"hmcalister/aocCommon/grid"
 	"regexp"
 
 	"github.com/rs/zerolog/log"
 )
 
 const (
-	LINE_LENGTH int  = 140
+	DAY              = 10
 	START_RUNE  rune = 'S'
 	GROUND_RUNE rune = '.'
 )
 
-var (
-	PipeMaze                  [][]rune
-	DirectionMap              map[directionEnum]map[rune]directionEnum
-	LoopPipeLinearCoordinates map[int]NodeData
-)
-
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	DirectionMap = createDirectionMap()
+	directionMap := createDirectionMap()
 
-	PipeMaze = make([][]rune, 0)
-	LoopPipeLinearCoordinates = make(map[int]NodeData)
+	loopPipeCoordinates := make(map[grid.Point]NodeData)
 
-	for fileScanner.Scan() {
-		line := fileScanner.Text()
-		PipeMaze = append(PipeMaze, []rune(line))
-		log.Trace().
-			Int("YCoord", len(PipeMaze)).
-			Str("Line", line).
-			Send()
+	pipeMaze, err := grid.ParseRunes(DAY, fileScanner)
+	if err != nil {
+		return 0, err
 	}
 
-	var startNode NodeData
-	for yCoord, row := range PipeMaze {
-		for xCoord, col := range row {
-			if col == START_RUNE {
-				startNode = NodeData{
-					XCoordinate: xCoord,
-					YCoordinate: yCoord,
-					NodeRune:    START_RUNE,
-				}
-			}
-		}
+	startCoordinates := pipeMaze.Find(func(r rune) bool { return r == START_RUNE })
+	if len(startCoordinates) != 1 {
+		return 0, errors.New("expected exactly one start rune in pipe maze")
+	}
+	startNode := NodeData{
+		Coordinate: startCoordinates[0],
+		NodeRune:   START_RUNE,
 	}
 
-	direction := determineStartDirection(startNode)
+	direction := determineStartDirection(pipeMaze, startNode)
 	currentNode := startNode
-	LoopPipeLinearCoordinates[currentNode.YCoordinate*LINE_LENGTH+currentNode.XCoordinate] = currentNode
+	loopPipeCoordinates[currentNode.Coordinate] = currentNode
 	log.Debug().
 		Interface("StartNode", currentNode).
 		Str("StartNodeRune", string(currentNode.NodeRune)).
@@ -57,15 +45,15 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 		Send()
 
 	loop := LoopData{
-		StartXCoordinate: startNode.XCoordinate,
-		StartYCoordinate: startNode.YCoordinate,
-		LoopNodes:        []NodeData{startNode},
-		LoopDirection:    []directionEnum{direction},
+		StartCoordinate: startNode.Coordinate,
+		LoopNodes:       []NodeData{startNode},
+		LoopDirection:   []grid.DirectionEnum{direction},
 	}
 
 	for {
-		currentNode = currentNode.nextNode(direction)
-		direction = DirectionMap[direction][currentNode.NodeRune]
+		currentNode = currentNode.nextNode(pipeMaze, direction)
+		nextDirection, isConnected := directionMap[direction][currentNode.NodeRune]
+		direction = nextDirection
 		log.Debug().
 			Interface("CurrentNode", currentNode).
 			Str("CurrentNodeRune", string(currentNode.NodeRune)).
@@ -75,10 +63,13 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 		if currentNode.NodeRune == START_RUNE {
 			break
 		}
+		if !isConnected {
+			return 0, fmt.Errorf("pipe loop is broken at %v", currentNode.Coordinate)
+		}
 
 		loop.LoopNodes = append(loop.LoopNodes, currentNode)
 		loop.LoopDirection = append(loop.LoopDirection, direction)
-		LoopPipeLinearCoordinates[currentNode.YCoordinate*LINE_LENGTH+currentNode.XCoordinate] = currentNode
+		loopPipeCoordinates[currentNode.Coordinate] = currentNode
 	}
 
 	log.Debug().Msg("finished parsing loop")
@@ -87,11 +78,11 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 		Interface("LoopDirections", loop.LoopDirection).
 		Send()
 
-	for yCoord := 0; yCoord < len(PipeMaze); yCoord += 1 {
-		for xCoord := 0; xCoord < LINE_LENGTH; xCoord += 1 {
-			linearCoordinate := yCoord*LINE_LENGTH + xCoord
-			if _, ok := LoopPipeLinearCoordinates[linearCoordinate]; !ok {
-				PipeMaze[yCoord][xCoord] = GROUND_RUNE
+	for yCoord := 0; yCoord < pipeMaze.Height; yCoord += 1 {
+		for xCoord := 0; xCoord < pipeMaze.Width; xCoord += 1 {
+			coordinate := grid.Point{X: xCoord, Y: yCoord}
+			if _, ok := loopPipeCoordinates[coordinate]; !ok {
+				pipeMaze.Set(coordinate, GROUND_RUNE)
 			}
 		}
 	}
@@ -101,8 +92,8 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 	loopCorner2 := regexp.MustCompile("L-*J")
 	loopBend1 := regexp.MustCompile("F-*J")
 	loopBend2 := regexp.MustCompile("L-*7")
-	for yCoord := 0; yCoord < len(PipeMaze); yCoord += 1 {
-		originalLine := string(PipeMaze[yCoord])
+	for yCoord := 0; yCoord < pipeMaze.Height; yCoord += 1 {
+		originalLine := string(pipeMaze.Row(yCoord))
 		line := originalLine
 		line = loopCorner1.ReplaceAllString(line, "")
 		line = loopCorner2.ReplaceAllString(line, "")
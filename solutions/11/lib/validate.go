@@ -0,0 +1,22 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+)
+
+const DAY = 11
+
+// Check the image is rectangular and made of empty space and galaxies
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+	lines := validate.ReadLines(fileScanner)
+
+	report.CheckUniformWidth(lines)
+	for lineIndex, line := range lines {
+		report.CheckRunes(line, lineIndex+1, ".#")
+	}
+
+	return report.Violations
+}
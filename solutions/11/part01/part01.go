@@ -2,19 +2,21 @@ package part01
 
 import (
 	"bufio"
+	"hmcalister/aocCommon/grid"
 
 	"github.com/rs/zerolog/log"
 )
 
 const (
+	DAY = 11
+
 	EMPTY_SPACE_RUNE rune = '.'
 	GALAXY_RUNE      rune = '#'
 )
 
 type galaxyData struct {
-	GalaxyID    int
-	XCoordinate int
-	YCoordinate int
+	GalaxyID   int
+	Coordinate grid.Point
 }
 
 type cosmologicalMapData struct {
@@ -23,52 +25,34 @@ type cosmologicalMapData struct {
 	Galaxies         []galaxyData
 }
 
-func newCosmologicalMap() *cosmologicalMapData {
-	return &cosmologicalMapData{
-		GalaxiesByRow:    make([]int, 0),
-		GalaxiesByColumn: make([]int, 0),
+func newCosmologicalMap(image *grid.Grid[rune]) *cosmologicalMapData {
+	cosmologicalMap := &cosmologicalMapData{
+		GalaxiesByRow:    make([]int, image.Height),
+		GalaxiesByColumn: make([]int, image.Width),
+		Galaxies:         make([]galaxyData, 0),
 	}
-}
 
-func (cosmologicalMap *cosmologicalMapData) addNewRow(line string) {
-	log.Debug().
-		Str("ParsingNextLine", line).
-		Send()
+	for _, coordinate := range image.Find(func(r rune) bool { return r == GALAXY_RUNE }) {
+		galaxy := galaxyData{
+			GalaxyID:   len(cosmologicalMap.Galaxies),
+			Coordinate: coordinate,
+		}
+		cosmologicalMap.Galaxies = append(cosmologicalMap.Galaxies, galaxy)
+		cosmologicalMap.GalaxiesByColumn[coordinate.X] += 1
+		cosmologicalMap.GalaxiesByRow[coordinate.Y] += 1
 
-	numCols := len(line)
-	if len(cosmologicalMap.GalaxiesByColumn) < numCols {
 		log.Debug().
-			Int("NewLineLength", numCols).
-			Int("CurrentMapByColumnsLen", len(cosmologicalMap.GalaxiesByColumn)).
-			Msg("Resizing")
-		newByColumnArr := make([]int, numCols)
-		copy(newByColumnArr, cosmologicalMap.GalaxiesByColumn)
-		cosmologicalMap.GalaxiesByColumn = newByColumnArr
+			Interface("FoundGalaxy", galaxy).
+			Int("GalaxiesByColumnCount", cosmologicalMap.GalaxiesByColumn[coordinate.X]).
+			Int("GalaxiesByRowCount", cosmologicalMap.GalaxiesByRow[coordinate.Y]).
+			Send()
 	}
 
-	totalGalaxiesInRow := 0
-	for i, r := range line {
-		if r == GALAXY_RUNE {
-			galaxy := galaxyData{
-				GalaxyID:    len(cosmologicalMap.Galaxies),
-				XCoordinate: i,
-				YCoordinate: len(cosmologicalMap.GalaxiesByRow),
-			}
-			cosmologicalMap.Galaxies = append(cosmologicalMap.Galaxies, galaxy)
-			cosmologicalMap.GalaxiesByColumn[i] += 1
-			totalGalaxiesInRow += 1
-
-			log.Debug().
-				Interface("FoundGalaxy", galaxy).
-				Int("GalaxiesByColumnCount", cosmologicalMap.GalaxiesByColumn[i]).
-				Int("GalaxiesByRowCount", totalGalaxiesInRow).
-				Send()
-		}
-	}
-	cosmologicalMap.GalaxiesByRow = append(cosmologicalMap.GalaxiesByRow, totalGalaxiesInRow)
+	return cosmologicalMap
 }
 
-func (cosmologicalMap *cosmologicalMapData) shortestDistanceBetweenPoints(x1, y1, x2, y2 int) int {
+func (cosmologicalMap *cosmologicalMapData) shortestDistanceBetweenPoints(pointOne, pointTwo grid.Point) int {
+	y1, y2 := pointOne.Y, pointTwo.Y
 	if y1 > y2 {
 		y2, y1 = y1, y2
 	}
@@ -80,6 +64,7 @@ func (cosmologicalMap *cosmologicalMapData) shortestDistanceBetweenPoints(x1, y1
 		}
 	}
 
+	x1, x2 := pointOne.X, pointTwo.X
 	if x1 > x2 {
 		x2, x1 = x1, x2
 	}
@@ -101,7 +86,7 @@ func (cosmologicalMap *cosmologicalMapData) calculateShortestPairwiseDistances()
 		galaxyOne := cosmologicalMap.Galaxies[i]
 		for j := i + 1; j < len(cosmologicalMap.Galaxies); j += 1 {
 			galaxyTwo := cosmologicalMap.Galaxies[j]
-			thisPairDistance := cosmologicalMap.shortestDistanceBetweenPoints(galaxyOne.XCoordinate, galaxyOne.YCoordinate, galaxyTwo.XCoordinate, galaxyTwo.YCoordinate)
+			thisPairDistance := cosmologicalMap.shortestDistanceBetweenPoints(galaxyOne.Coordinate, galaxyTwo.Coordinate)
 			totalPairwiseDistances += thisPairDistance
 			totalPairs += 1
 
@@ -121,11 +106,11 @@ func (cosmologicalMap *cosmologicalMapData) calculateShortestPairwiseDistances()
 }
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	cosmologicalMap := newCosmologicalMap()
-	for fileScanner.Scan() {
-		line := fileScanner.Text()
-		cosmologicalMap.addNewRow(line)
+	image, err := grid.ParseRunes(DAY, fileScanner)
+	if err != nil {
+		return 0, err
 	}
+	cosmologicalMap := newCosmologicalMap(image)
 
 	totalShortestDistances := cosmologicalMap.calculateShortestPairwiseDistances()
 
@@ -0,0 +1,14 @@
+package part01
+
+import (
+	"hmcalister/aocCommon/golden"
+	"testing"
+)
+
+func TestProcessInput(t *testing.T) {
+	golden.TestSolver(t, ProcessInput)
+}
+
+func BenchmarkProcessInput(b *testing.B) {
+	golden.BenchmarkSolver(b, ProcessInput)
+}
@@ -8,6 +8,8 @@ import (
 )
 
 const (
+	DAY = 12
+
 	DAMAGED_SPRING_RUNE     rune = '#'
 	OPERATIONAL_SPRING_RUNE rune = '.'
 	UNKNOWN_SPRING_RUNE     rune = '?'
@@ -0,0 +1,26 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+	"regexp"
+)
+
+// A row of springs followed by the sizes of the contiguous groups of damaged springs
+var springRowLineRegexp = regexp.MustCompile(`^[.#?]+ [0-9]+(,[0-9]+)*$`)
+
+// Check each line is a row of springs and a list of damaged group sizes like "???.### 1,1,3"
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+	lines := validate.ReadLines(fileScanner)
+
+	if len(lines) == 0 {
+		report.Addf(0, 0, "", "input is empty")
+	}
+	for lineIndex, line := range lines {
+		report.CheckPattern(line, lineIndex+1, springRowLineRegexp, "???.### 1,1,3")
+	}
+
+	return report.Violations
+}
@@ -3,28 +3,31 @@ package part02
 import (
 	"bufio"
 	"hmcalister/aoc12/lib"
-	"strconv"
+	"hmcalister/aocCommon/parse"
 	"strings"
 
 	"github.com/rs/zerolog/log"
 )
 
-func parseLineToSpringRowData(line string) lib.SpringRowData {
+func parseLineToSpringRowData(line string, lineNumber int) (lib.SpringRowData, error) {
 	fields := strings.Fields(line)
 	log.Trace().
 		Str("ParsedLine", line).
 		Interface("Fields", fields).
 		Msg("Parsing Line To Data")
+	if len(fields) != 2 {
+		return lib.SpringRowData{}, parse.Errorf(lib.DAY, lineNumber, 0, line, "expected a row of springs and a list of damaged group sizes")
+	}
 
-	contiguousDamagedGroupDataStrs := strings.Split(fields[1], ",")
-	contiguousDamagedGroupData := make([]int, len(contiguousDamagedGroupDataStrs))
-	for i, str := range contiguousDamagedGroupDataStrs {
-		parsedInt, err := strconv.Atoi(str)
+	contiguousDamagedGroupFields := parse.Split(fields[1], ",", strings.LastIndex(line, fields[1])+1)
+	contiguousDamagedGroupData := make([]int, len(contiguousDamagedGroupFields))
+	for i, field := range contiguousDamagedGroupFields {
+		parsedInt, err := parse.Atoi(lib.DAY, lineNumber, field)
 		if err != nil {
-			log.Fatal().Msgf("failed to parsed contiguousDamagedGroup string %v to integer on line %v", str, line)
+			return lib.SpringRowData{}, err
 		}
 		log.Trace().
-			Str("ContiguousGroupString", str).
+			Str("ContiguousGroupString", field.Text).
 			Int("ParsedInt", parsedInt).
 			Send()
 		contiguousDamagedGroupData[i] = parsedInt
@@ -41,15 +44,20 @@ func parseLineToSpringRowData(line string) lib.SpringRowData {
 	return lib.SpringRowData{
 		RowLine:                    rowLine,
 		ContiguousDamagedGroupData: fiveTimesContiguousDamagedGroupData,
-	}
+	}, nil
 }
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 	result := 0
 	possibleArrangementsCalculator := lib.NewPossibleArrangementsCalculator()
+	lineNumber := 0
 	for fileScanner.Scan() {
+		lineNumber += 1
 		line := fileScanner.Text()
-		row := parseLineToSpringRowData(line)
+		row, err := parseLineToSpringRowData(line, lineNumber)
+		if err != nil {
+			return 0, err
+		}
 		rowArrangements := possibleArrangementsCalculator.CalculatePossibleArrangements(row.RowLine, row.ContiguousDamagedGroupData)
 		result += rowArrangements
 
@@ -2,12 +2,16 @@ package lib
 
 import (
 	"bufio"
-	"errors"
+	"fmt"
+	"hmcalister/aocCommon/grid"
+	"hmcalister/aocCommon/input"
 
 	"github.com/rs/zerolog/log"
 )
 
 const (
+	DAY = 13
+
 	ASH_RUNE  = '.'
 	ROCK_RUNE = '#'
 )
@@ -20,49 +24,44 @@ func reverseString(s string) string {
 	return string(reversedRunes)
 }
 
-func parseSectionToPattern(fileScanner *bufio.Scanner, patternID int) (PatternData, error) {
-	var line string
-	currentPatternRows := make([]string, 0)
-	// Take from the file scanner until the next blank line
-	for {
-		if !fileScanner.Scan() {
-			log.Trace().Msg("End of File found")
-			return PatternData{}, errors.New("end of file")
-		}
-		line = fileScanner.Text()
-		// Check if we have reached the end of a pattern
-		if len(line) == 0 {
-			log.Trace().Msg("Blank line found")
-			break
-		}
-
-		log.Trace().
-			Str("NextLine", line).
-			Send()
-		currentPatternRows = append(currentPatternRows, line)
+func parsePatternRune(r rune) (rune, error) {
+	if r != ASH_RUNE && r != ROCK_RUNE {
+		return 0, fmt.Errorf("unexpected pattern rune %q", r)
 	}
+	return r, nil
+}
 
-	currentPattern := newPatternData(patternID, currentPatternRows)
+func parseBlockToPattern(block input.Block, patternID int) (PatternData, error) {
+	log.Trace().
+		Int("PatternID", patternID).
+		Strs("PatternLines", block.Lines).
+		Msg("Start Parsing Pattern")
+
+	patternGrid, err := grid.FromLines(DAY, block.Lines, block.FirstLineNumber, parsePatternRune)
+	if err != nil {
+		return PatternData{}, err
+	}
+	currentPattern := newPatternData(patternID, patternGrid)
 
 	log.Trace().Msgf("Finished Parsing Pattern %v", currentPattern.PatternID)
 	return currentPattern, nil
 }
 
-func ParseFileToPatterns(fileScanner *bufio.Scanner) []PatternData {
-
+// Parse each blank line separated pattern of the input, the last of which need not be followed by a blank line
+func ParseFileToPatterns(fileScanner *bufio.Scanner) ([]PatternData, error) {
 	filePatterns := make([]PatternData, 0)
 
-	for {
-		log.Trace().
-			Int("PatternID", len(filePatterns)).
-			Msg("Start Parsing Pattern")
-
-		newPattern, err := parseSectionToPattern(fileScanner, len(filePatterns))
+	blocks := input.Blocks(input.FromScanner(fileScanner))
+	for blocks.Next() {
+		newPattern, err := parseBlockToPattern(blocks.Value(), len(filePatterns))
 		if err != nil {
-			break
+			return nil, err
 		}
 		filePatterns = append(filePatterns, newPattern)
 	}
+	if err := blocks.Err(); err != nil {
+		return nil, err
+	}
 
-	return filePatterns
+	return filePatterns, nil
 }
@@ -1,6 +1,14 @@
 package lib
 
-import "github.com/rs/zerolog/log"
+import (
+	"errors"
+	"fmt"
+	"hmcalister/aocCommon/grid"
+
+	"github.com/rs/zerolog/log"
+)
+
+var ErrNoUniqueReflection = errors.New("failed to find unique reflection indices")
 
 type PatternData struct {
 	PatternID int
@@ -8,14 +16,17 @@ type PatternData struct {
 	Columns   []string
 }
 
-func newPatternData(ID int, rows []string) PatternData {
-	columns := make([]string, len(rows[0]))
+func newPatternData(ID int, pattern *grid.Grid[rune]) PatternData {
+	rows := make([]string, pattern.Height)
+	for rowIndex := range rows {
+		rows[rowIndex] = string(pattern.Row(rowIndex))
+	}
+
+	// The columns of the pattern are the rows of its transpose
+	transposedPattern := pattern.Transpose()
+	columns := make([]string, transposedPattern.Height)
 	for columnIndex := range columns {
-		columnRunes := make([]rune, len(rows))
-		for rowIndex, row := range rows {
-			columnRunes[rowIndex] = rune(row[columnIndex])
-		}
-		columns[columnIndex] = string(columnRunes)
+		columns[columnIndex] = string(transposedPattern.Row(columnIndex))
 	}
 
 	return PatternData{
@@ -25,7 +36,7 @@ func newPatternData(ID int, rows []string) PatternData {
 	}
 }
 
-func (pattern PatternData) FindReflectionsIndices() (int, int) {
+func (pattern PatternData) FindReflectionsIndices() (int, int, error) {
 	rowReflectionIndexCounts := make(map[int]int)
 	// Find the possible reflections across all rows
 	for rowIndex, row := range pattern.Rows {
@@ -88,17 +99,16 @@ func (pattern PatternData) FindReflectionsIndices() (int, int) {
 		Send()
 
 	if len(rowReflectionIndices) == 1 {
-		return rowReflectionIndices[0], 0
+		return rowReflectionIndices[0], 0, nil
 
 	} else if len(columnReflectionIndices) == 1 {
-		return 0, columnReflectionIndices[0]
+		return 0, columnReflectionIndices[0], nil
 	} else {
-		log.Fatal().Msgf("Failed to find unique reflection indices for pattern %v", pattern.PatternID)
-		return 0, 0
+		return 0, 0, fmt.Errorf("pattern %v: %w", pattern.PatternID, ErrNoUniqueReflection)
 	}
 }
 
-func (pattern PatternData) FindSmudgedReflectionsIndices() (int, int) {
+func (pattern PatternData) FindSmudgedReflectionsIndices() (int, int, error) {
 	rowReflectionIndexCounts := make(map[int]int)
 	// Find the possible reflections across all rows
 	for rowIndex, row := range pattern.Rows {
@@ -161,12 +171,11 @@ func (pattern PatternData) FindSmudgedReflectionsIndices() (int, int) {
 		Send()
 
 	if len(rowReflectionIndices) == 1 {
-		return rowReflectionIndices[0], 0
+		return rowReflectionIndices[0], 0, nil
 
 	} else if len(columnReflectionIndices) == 1 {
-		return 0, columnReflectionIndices[0]
+		return 0, columnReflectionIndices[0], nil
 	} else {
-		log.Fatal().Msgf("Failed to find unique reflection indices for pattern %v", pattern.PatternID)
-		return 0, 0
+		return 0, 0, fmt.Errorf("pattern %v: %w", pattern.PatternID, ErrNoUniqueReflection)
 	}
 }
@@ -0,0 +1,20 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+)
+
+// Check the input is blank line separated rectangular patterns of ash and rocks.
+//
+// Each part looks for a different reflection, so a pattern without one is reported when solving
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+
+	if _, err := ParseFileToPatterns(fileScanner); err != nil {
+		report.Add(err)
+	}
+
+	return report.Violations
+}
@@ -8,13 +8,19 @@ import (
 )
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	filePatterns := lib.ParseFileToPatterns(fileScanner)
+	filePatterns, err := lib.ParseFileToPatterns(fileScanner)
+	if err != nil {
+		return 0, err
+	}
 	log.Debug().Int("NumberOfPatterns", len(filePatterns)).Send()
 
 	result := 0
 	for _, pattern := range filePatterns {
 		log.Debug().Interface("Pattern", pattern).Send()
-		rowReflectionIndex, columnReflectionIndex := pattern.FindReflectionsIndices()
+		rowReflectionIndex, columnReflectionIndex, err := pattern.FindReflectionsIndices()
+		if err != nil {
+			return 0, err
+		}
 
 		result += rowReflectionIndex + 100*columnReflectionIndex
 	}
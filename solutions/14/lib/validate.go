@@ -0,0 +1,22 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+)
+
+const DAY = 14
+
+// Check the platform is rectangular and made of round rocks, cube rocks, and empty space
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+	lines := validate.ReadLines(fileScanner)
+
+	report.CheckUniformWidth(lines)
+	for lineIndex, line := range lines {
+		report.CheckRunes(line, lineIndex+1, "O#.")
+	}
+
+	return report.Violations
+}
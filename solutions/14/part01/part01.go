@@ -2,53 +2,96 @@ package part01
 
 import (
 	"bufio"
+	"fmt"
+	"hmcalister/aocCommon/grid"
+	"hmcalister/aocCommon/render"
+	"hmcalister/aocCommon/stepview"
+	"image/color"
 
 	"github.com/rs/zerolog/log"
 )
 
 const (
+	DAY = 14
+
 	EMPTY_SPACE  byte = '.'
 	ROUNDED_ROCK byte = 'O'
 	CUBE_ROCK    byte = '#'
 )
 
+var platformPalette = render.Palette[byte]{
+	EMPTY_SPACE:  color.RGBA{0x20, 0x20, 0x20, 0xff},
+	ROUNDED_ROCK: color.RGBA{0xd0, 0xd0, 0xd0, 0xff},
+	CUBE_ROCK:    color.RGBA{0x80, 0x50, 0x30, 0xff},
+}
+
+// Optional outputs of ProcessInputWithOptions, beyond the answer
+type Options struct {
+	// If not empty, render the platform after the rocks have rolled to a PNG file at this path
+	RenderPath string
+	// If not nil, record the platform before and after the roll to it
+	Recorder *stepview.Recorder
+}
+
 type PlatformData struct {
-	initialRows []string
-	currentRows [][]byte
+	currentRows *grid.Grid[byte]
+
+	// If set, called after each roll of the platform with a description of the step
+	OnStep func(description string)
 }
 
-func NewPlatformData(rowStrings []string) PlatformData {
-	rowData := make([][]byte, len(rowStrings))
-	for rowIndex, row := range rowStrings {
-		currRow := make([]byte, len(row))
-		for colIndex, col := range row {
-			currRow[colIndex] = byte(col)
-		}
-		rowData[rowIndex] = currRow
+func parsePlatformRune(r rune) (byte, error) {
+	switch byte(r) {
+	case EMPTY_SPACE, ROUNDED_ROCK, CUBE_ROCK:
+		return byte(r), nil
+	}
+	return 0, fmt.Errorf("unknown platform rune %q", r)
+}
+
+func formatPlatformCell(state byte) rune {
+	return rune(state)
+}
+
+func NewPlatformData(fileScanner *bufio.Scanner) (PlatformData, error) {
+	rowData, err := grid.Parse(DAY, fileScanner, parsePlatformRune)
+	if err != nil {
+		return PlatformData{}, err
 	}
 
 	return PlatformData{
-		initialRows: rowStrings,
 		currentRows: rowData,
-	}
+	}, nil
 }
 
 func (platform PlatformData) LogCurrentRows() {
-	for rowIndex, row := range platform.currentRows {
+	for rowIndex := 0; rowIndex < platform.currentRows.Height; rowIndex += 1 {
 		log.Debug().
-			Str("Row", string(row)).
+			Str("Row", string(platform.currentRows.Row(rowIndex))).
 			Int("RowIndex", rowIndex).
 			Send()
 	}
 }
 
+// Render the platform to a PNG file
+func (platform PlatformData) Render(filePath string) error {
+	return render.Grid(platform.currentRows, platformPalette, render.DEFAULT_CELL_SIZE).WritePNG(filePath)
+}
+
+// Report a step of the simulation to the OnStep hook, if set
+func (platform PlatformData) step(description string) {
+	if platform.OnStep != nil {
+		platform.OnStep(description)
+	}
+}
+
 func (platform PlatformData) RollNorth() {
-	for colIndex := 0; colIndex < len(platform.currentRows[0]); colIndex += 1 {
-		stoppingIndex := -1
-		for rowIndex := 0; rowIndex < len(platform.currentRows); rowIndex += 1 {
-			currentState := platform.currentRows[rowIndex][colIndex]
+	for _, columnTop := range platform.currentRows.Edge(grid.DIRECTION_UP) {
+		// The coordinate of the last blocking position, which starts just off the edge of the platform
+		stoppingCoord := columnTop.Move(grid.DIRECTION_UP)
+		for currentCoord := columnTop; platform.currentRows.InBounds(currentCoord); currentCoord = currentCoord.Move(grid.DIRECTION_DOWN) {
+			currentState := platform.currentRows.Get(currentCoord)
 			log.Trace().
-				Interface("Coordinates", []int{rowIndex, colIndex}).
+				Stringer("Coordinates", currentCoord).
 				Str("CurrentState", string(currentState)).
 				Send()
 			switch currentState {
@@ -56,59 +99,61 @@ func (platform PlatformData) RollNorth() {
 				// If the current space is empty, we need not update anything and can simply continue
 				continue
 			case CUBE_ROCK:
-				// If we encounter a new cube rock, we must update the stoppingIndex
+				// If we encounter a new cube rock, we must update the stoppingCoord
 				// Such that the next rounded rock will only roll to this position
-				stoppingIndex = rowIndex
+				stoppingCoord = currentCoord
 			case ROUNDED_ROCK:
 				// If we encounter a rounded rock that can roll, it's time to update things!
-				// The rock will roll to the stoppingIndex, as tracked above.
-				// Importantly, the stoppingIndex is the index of the *block*,
+				// The rock will roll to the stoppingCoord, as tracked above.
+				// Importantly, the stoppingCoord is the position of the *block*,
 				// so we only roll to one before it
 
 				// This position is now empty, as the rock will roll
-				platform.currentRows[rowIndex][colIndex] = EMPTY_SPACE
+				platform.currentRows.Set(currentCoord, EMPTY_SPACE)
 
-				// The rounded rock in this position will roll away to the stopping index
-				platform.currentRows[stoppingIndex+1][colIndex] = ROUNDED_ROCK
-
-				// The stoppingIndex of this column is now the roundedRock we just updated
-				stoppingIndex = stoppingIndex + 1
-			default:
-				log.Fatal().Msgf("Encountered unknown character at (%v, %v): %v", colIndex, rowIndex, currentState)
+				// The rounded rock in this position will roll away to the stopping position
+				stoppingCoord = stoppingCoord.Move(grid.DIRECTION_DOWN)
+				platform.currentRows.Set(stoppingCoord, ROUNDED_ROCK)
 			}
 		}
 	}
+	platform.step("RollNorth")
 }
 
 func (platform PlatformData) CalculateLoad() int {
 	totalLoad := 0
-	for rowIndex := 0; rowIndex < len(platform.currentRows); rowIndex += 1 {
-		for colIndex := 0; colIndex < len(platform.currentRows[0]); colIndex += 1 {
-			currentState := platform.currentRows[rowIndex][colIndex]
-			if currentState == ROUNDED_ROCK {
-				totalLoad += len(platform.currentRows) - rowIndex
-			}
-		}
+	for _, rockCoord := range platform.currentRows.Find(func(state byte) bool { return state == ROUNDED_ROCK }) {
+		totalLoad += platform.currentRows.Height - rockCoord.Y
 	}
 
 	return totalLoad
 }
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	rowStrings := make([]string, 0)
-	for fileScanner.Scan() {
-		line := fileScanner.Text()
-		rowStrings = append(rowStrings, line)
-		log.Debug().
-			Str("ParsedLine", line).
-			Send()
-	}
+	return ProcessInputWithOptions(fileScanner, Options{})
+}
 
-	platform := NewPlatformData(rowStrings)
+func ProcessInputWithOptions(fileScanner *bufio.Scanner, options Options) (int, error) {
+	platform, err := NewPlatformData(fileScanner)
+	if err != nil {
+		return 0, err
+	}
 	platform.LogCurrentRows()
+	if options.Recorder != nil {
+		platform.OnStep = func(description string) {
+			options.Recorder.Record(description, func() string { return platform.currentRows.Format(formatPlatformCell) })
+		}
+		platform.step("Initial platform")
+	}
 	log.Debug().Msg("Roll North")
 	platform.RollNorth()
 	platform.LogCurrentRows()
+	if options.RenderPath != "" {
+		if err := platform.Render(options.RenderPath); err != nil {
+			return 0, err
+		}
+	}
+
 	totalLoad := platform.CalculateLoad()
 
 	return totalLoad, nil
@@ -2,230 +2,162 @@ package part02
 
 import (
 	"bufio"
+	"fmt"
 	"hash"
 	"hash/fnv"
+	"hmcalister/aocCommon/grid"
+	"hmcalister/aocCommon/render"
+	"hmcalister/aocCommon/stepview"
+	"image/color"
 	"slices"
-	"strings"
 
 	"github.com/rs/zerolog/log"
 )
 
 const (
+	DAY = 14
+
 	EMPTY_SPACE  byte = '.'
 	ROUNDED_ROCK byte = 'O'
 	CUBE_ROCK    byte = '#'
 )
 
+var platformPalette = render.Palette[byte]{
+	EMPTY_SPACE:  color.RGBA{0x20, 0x20, 0x20, 0xff},
+	ROUNDED_ROCK: color.RGBA{0xd0, 0xd0, 0xd0, 0xff},
+	CUBE_ROCK:    color.RGBA{0x80, 0x50, 0x30, 0xff},
+}
+
+// Optional outputs of ProcessInputWithOptions, beyond the answer
+type Options struct {
+	// If not empty, render the platform after the rocks have rolled to a PNG file at this path
+	RenderPath string
+	// If not nil, record the platform after each roll to it
+	Recorder *stepview.Recorder
+}
+
 type PlatformData struct {
-	rows         [][]byte
-	cache        map[uint64]string
+	rows         *grid.Grid[byte]
+	cache        map[uint64]*grid.Grid[byte]
 	hashIndices  []uint64
 	hashFunction hash.Hash64
+
+	// If set, called after each roll of the platform with a description of the step
+	OnStep func(description string)
 }
 
-func convertStringsToByteArrays(strings []string) [][]byte {
-	byteData := make([][]byte, len(strings))
-	for rowIndex, row := range strings {
-		currRow := make([]byte, len(row))
-		for colIndex, col := range row {
-			currRow[colIndex] = byte(col)
-		}
-		byteData[rowIndex] = currRow
+func parsePlatformRune(r rune) (byte, error) {
+	switch byte(r) {
+	case EMPTY_SPACE, ROUNDED_ROCK, CUBE_ROCK:
+		return byte(r), nil
 	}
-
-	return byteData
+	return 0, fmt.Errorf("unknown platform rune %q", r)
 }
 
-func convertByteArraysToStrings(byteArrays [][]byte) []string {
-	strings := make([]string, len(byteArrays))
-	for byteArrayIndex, byteArray := range byteArrays {
-		strings[byteArrayIndex] = string(byteArray)
-	}
-	return strings
+func formatPlatformCell(state byte) rune {
+	return rune(state)
 }
 
-func NewPlatformData(rowStrings []string) *PlatformData {
-	rowData := convertStringsToByteArrays(rowStrings)
+func NewPlatformData(fileScanner *bufio.Scanner) (*PlatformData, error) {
+	rowData, err := grid.Parse(DAY, fileScanner, parsePlatformRune)
+	if err != nil {
+		return nil, err
+	}
 
 	return &PlatformData{
 		rows:         rowData,
-		cache:        make(map[uint64]string),
+		cache:        make(map[uint64]*grid.Grid[byte]),
 		hashIndices:  make([]uint64, 0),
 		hashFunction: fnv.New64a(),
-	}
+	}, nil
 }
 
 func (platform *PlatformData) LogCurrentRows() {
-	for rowIndex, row := range platform.rows {
+	for rowIndex := 0; rowIndex < platform.rows.Height; rowIndex += 1 {
 		log.Debug().
-			Str("Row", string(row)).
+			Str("Row", string(platform.rows.Row(rowIndex))).
 			Int("RowIndex", rowIndex).
 			Send()
 	}
 }
 
-func (platform *PlatformData) convertRowsToString() string {
-	return strings.Join(convertByteArraysToStrings(platform.rows), "\n")
+// Render the platform to a PNG file
+func (platform *PlatformData) Render(filePath string) error {
+	return render.Grid(platform.rows, platformPalette, render.DEFAULT_CELL_SIZE).WritePNG(filePath)
 }
 
-func (platform *PlatformData) RollNorth() {
-	log.Trace().Msg("RollNorth")
-	for colIndex := 0; colIndex < len(platform.rows[0]); colIndex += 1 {
-		stoppingIndex := -1
-		for rowIndex := 0; rowIndex < len(platform.rows); rowIndex += 1 {
-			currentState := platform.rows[rowIndex][colIndex]
-			// log.Trace().
-			// 	Interface("Coordinates", []int{rowIndex, colIndex}).
-			// 	Str("CurrentState", string(currentState)).
-			// 	Send()
+// Report a step of the simulation to the OnStep hook, if set
+func (platform *PlatformData) step(description string) {
+	if platform.OnStep != nil {
+		platform.OnStep(description)
+	}
+}
+
+func (platform *PlatformData) hashRows() uint64 {
+	platform.hashFunction.Reset()
+	platform.hashFunction.Write([]byte(platform.rows.Format(formatPlatformCell)))
+	return platform.hashFunction.Sum64()
+}
+
+// Roll every rounded rock as far as it will go in the given direction
+func (platform *PlatformData) roll(direction grid.DirectionEnum) {
+	// Walk each line of the platform away from the edge the rocks roll towards
+	walkDirection := direction.Opposite()
+	for _, lineStart := range platform.rows.Edge(direction) {
+		// The coordinate of the last blocking position, which starts just off the edge of the platform
+		stoppingCoord := lineStart.Move(direction)
+		for currentCoord := lineStart; platform.rows.InBounds(currentCoord); currentCoord = currentCoord.Move(walkDirection) {
+			currentState := platform.rows.Get(currentCoord)
 			switch currentState {
 			case EMPTY_SPACE:
 				// If the current space is empty, we need not update anything and can simply continue
 				continue
 			case CUBE_ROCK:
-				// If we encounter a new cube rock, we must update the stoppingIndex
+				// If we encounter a new cube rock, we must update the stoppingCoord
 				// Such that the next rounded rock will only roll to this position
-				stoppingIndex = rowIndex
+				stoppingCoord = currentCoord
 			case ROUNDED_ROCK:
 				// If we encounter a rounded rock that can roll, it's time to update things!
-				// The rock will roll to the stoppingIndex, as tracked above.
-				// Importantly, the stoppingIndex is the index of the *block*,
+				// The rock will roll to the stoppingCoord, as tracked above.
+				// Importantly, the stoppingCoord is the position of the *block*,
 				// so we only roll to one before it
 
 				// This position is now empty, as the rock will roll
-				platform.rows[rowIndex][colIndex] = EMPTY_SPACE
-
-				// The rounded rock in this position will roll away to the stopping index
-				platform.rows[stoppingIndex+1][colIndex] = ROUNDED_ROCK
+				platform.rows.Set(currentCoord, EMPTY_SPACE)
 
-				// The stoppingIndex of this column is now the roundedRock we just updated
-				stoppingIndex = stoppingIndex + 1
-			default:
-				log.Fatal().Msgf("Encountered unknown character at (%v, %v): %v", colIndex, rowIndex, currentState)
+				// The rounded rock in this position will roll away to the stopping position
+				stoppingCoord = stoppingCoord.Move(walkDirection)
+				platform.rows.Set(stoppingCoord, ROUNDED_ROCK)
 			}
 		}
 	}
 }
 
+func (platform *PlatformData) RollNorth() {
+	log.Trace().Msg("RollNorth")
+	platform.roll(grid.DIRECTION_UP)
+	platform.step("RollNorth")
+}
+
 func (platform *PlatformData) RollSouth() {
 	log.Trace().Msg("RollSouth")
-	for colIndex := 0; colIndex < len(platform.rows[0]); colIndex += 1 {
-		stoppingIndex := len(platform.rows)
-		for rowIndex := len(platform.rows) - 1; rowIndex >= 0; rowIndex -= 1 {
-			currentState := platform.rows[rowIndex][colIndex]
-			// log.Trace().
-			// 	Interface("Coordinates", []int{rowIndex, colIndex}).
-			// 	Str("CurrentState", string(currentState)).
-			// 	Int("StoppingIndex", stoppingIndex).
-			// 	Send()
-			switch currentState {
-			case EMPTY_SPACE:
-				// If the current space is empty, we need not update anything and can simply continue
-				continue
-			case CUBE_ROCK:
-				// If we encounter a new cube rock, we must update the stoppingIndex
-				// Such that the next rounded rock will only roll to this position
-				stoppingIndex = rowIndex
-			case ROUNDED_ROCK:
-				// If we encounter a rounded rock that can roll, it's time to update things!
-				// The rock will roll to the stoppingIndex, as tracked above.
-				// Importantly, the stoppingIndex is the index of the *block*,
-				// so we only roll to one before it
-
-				// This position is now empty, as the rock will roll
-				platform.rows[rowIndex][colIndex] = EMPTY_SPACE
-
-				// The rounded rock in this position will roll away to the stopping index
-				platform.rows[stoppingIndex-1][colIndex] = ROUNDED_ROCK
-
-				// The stoppingIndex of this column is now the roundedRock we just updated
-				stoppingIndex = stoppingIndex - 1
-			default:
-				log.Fatal().Msgf("Encountered unknown character at (%v, %v): %v", colIndex, rowIndex, currentState)
-			}
-		}
-	}
+	platform.roll(grid.DIRECTION_DOWN)
+	platform.step("RollSouth")
 }
 
 func (platform *PlatformData) RollWest() {
 	log.Trace().Msg("RollWest")
-	for rowIndex := 0; rowIndex < len(platform.rows); rowIndex += 1 {
-		stoppingIndex := -1
-		for colIndex := 0; colIndex < len(platform.rows[0]); colIndex += 1 {
-			currentState := platform.rows[rowIndex][colIndex]
-			// log.Trace().
-			// 	Interface("Coordinates", []int{rowIndex, colIndex}).
-			// 	Str("CurrentState", string(currentState)).
-			// 	Send()
-			switch currentState {
-			case EMPTY_SPACE:
-				// If the current space is empty, we need not update anything and can simply continue
-				continue
-			case CUBE_ROCK:
-				// If we encounter a new cube rock, we must update the stoppingIndex
-				// Such that the next rounded rock will only roll to this position
-				stoppingIndex = colIndex
-			case ROUNDED_ROCK:
-				// If we encounter a rounded rock that can roll, it's time to update things!
-				// The rock will roll to the stoppingIndex, as tracked above.
-				// Importantly, the stoppingIndex is the index of the *block*,
-				// so we only roll to one before it
-
-				// This position is now empty, as the rock will roll
-				platform.rows[rowIndex][colIndex] = EMPTY_SPACE
-
-				// The rounded rock in this position will roll away to the stopping index
-				platform.rows[rowIndex][stoppingIndex+1] = ROUNDED_ROCK
-
-				// The stoppingIndex of this column is now the roundedRock we just updated
-				stoppingIndex = stoppingIndex + 1
-			default:
-				log.Fatal().Msgf("Encountered unknown character at (%v, %v): %v", colIndex, rowIndex, currentState)
-			}
-		}
-	}
+	platform.roll(grid.DIRECTION_LEFT)
+	platform.step("RollWest")
 }
 
 func (platform *PlatformData) RollEast() {
 	log.Trace().Msg("RollEast")
-	for rowIndex := 0; rowIndex < len(platform.rows); rowIndex += 1 {
-		stoppingIndex := len(platform.rows)
-		for colIndex := len(platform.rows[0]) - 1; colIndex >= 0; colIndex -= 1 {
-			currentState := platform.rows[rowIndex][colIndex]
-			// log.Trace().
-			// 	Interface("Coordinates", []int{rowIndex, colIndex}).
-			// 	Str("CurrentState", string(currentState)).
-			// 	Send()
-			switch currentState {
-			case EMPTY_SPACE:
-				// If the current space is empty, we need not update anything and can simply continue
-				continue
-			case CUBE_ROCK:
-				// If we encounter a new cube rock, we must update the stoppingIndex
-				// Such that the next rounded rock will only roll to this position
-				stoppingIndex = colIndex
-			case ROUNDED_ROCK:
-				// If we encounter a rounded rock that can roll, it's time to update things!
-				// The rock will roll to the stoppingIndex, as tracked above.
-				// Importantly, the stoppingIndex is the index of the *block*,
-				// so we only roll to one before it
-
-				// This position is now empty, as the rock will roll
-				platform.rows[rowIndex][colIndex] = EMPTY_SPACE
-
-				// The rounded rock in this position will roll away to the stopping index
-				platform.rows[rowIndex][stoppingIndex-1] = ROUNDED_ROCK
-
-				// The stoppingIndex of this column is now the roundedRock we just updated
-				stoppingIndex = stoppingIndex - 1
-			default:
-				log.Fatal().Msgf("Encountered unknown character at (%v, %v): %v", colIndex, rowIndex, currentState)
-			}
-		}
-	}
+	platform.roll(grid.DIRECTION_RIGHT)
+	platform.step("RollEast")
 }
 
-func (platform *PlatformData) addToCache(stateBeforeHash uint64, stateAfter string) {
+func (platform *PlatformData) addToCache(stateBeforeHash uint64, stateAfter *grid.Grid[byte]) {
 	platform.cache[stateBeforeHash] = stateAfter
 	platform.hashIndices = append(platform.hashIndices, stateBeforeHash)
 
@@ -236,10 +168,7 @@ func (platform *PlatformData) PerformCycles(numberOfCycles int) {
 	cycleIndex := 0
 	for ; cycleIndex < numberOfCycles; cycleIndex += 1 {
 
-		stateBefore := platform.convertRowsToString()
-		platform.hashFunction.Reset()
-		platform.hashFunction.Write([]byte(stateBefore))
-		stateBeforeHash := platform.hashFunction.Sum64()
+		stateBeforeHash := platform.hashRows()
 
 		log.Debug().
 			Int("CycleIndex", cycleIndex).
@@ -251,8 +180,6 @@ func (platform *PlatformData) PerformCycles(numberOfCycles int) {
 				Int("TotalCycles", len(platform.hashIndices)).
 				Int("CurrentStateIndex", slices.Index(platform.hashIndices, stateBeforeHash)).
 				Msg("CACHE HIT")
-			// resultAsByteArrays := convertStringsToByteArrays(strings.Split(result, "\n"))
-			// platform.rows = resultAsByteArrays
 			break
 		}
 
@@ -261,14 +188,10 @@ func (platform *PlatformData) PerformCycles(numberOfCycles int) {
 		platform.RollSouth()
 		platform.RollEast()
 		platform.LogCurrentRows()
-		stateAfter := platform.convertRowsToString()
-		platform.addToCache(stateBeforeHash, stateAfter)
+		platform.addToCache(stateBeforeHash, platform.rows.Clone())
 	}
 
-	stateBefore := platform.convertRowsToString()
-	platform.hashFunction.Reset()
-	platform.hashFunction.Write([]byte(stateBefore))
-	stateBeforeHash := platform.hashFunction.Sum64()
+	stateBeforeHash := platform.hashRows()
 
 	loopStartIndex := slices.Index(platform.hashIndices, stateBeforeHash)
 	loopLength := (len(platform.hashIndices) - loopStartIndex)
@@ -283,45 +206,45 @@ func (platform *PlatformData) PerformCycles(numberOfCycles int) {
 		Send()
 
 	for i := 0; i < additionalIterations; i += 1 {
-		stateBefore := platform.convertRowsToString()
-		platform.hashFunction.Reset()
-		platform.hashFunction.Write([]byte(stateBefore))
-		stateBeforeHash := platform.hashFunction.Sum64()
-
-		platform.rows = convertStringsToByteArrays(strings.Split(platform.cache[stateBeforeHash], "\n"))
+		platform.rows = platform.cache[platform.hashRows()].Clone()
+		platform.step(fmt.Sprintf("Cycle %v of %v from cache", numberOfCycles-additionalIterations+i+1, numberOfCycles))
 	}
 }
 
 func (platform *PlatformData) CalculateLoad() int {
 	totalLoad := 0
-	for rowIndex := 0; rowIndex < len(platform.rows); rowIndex += 1 {
-		for colIndex := 0; colIndex < len(platform.rows[0]); colIndex += 1 {
-			currentState := platform.rows[rowIndex][colIndex]
-			if currentState == ROUNDED_ROCK {
-				totalLoad += len(platform.rows) - rowIndex
-			}
-		}
+	for _, rockCoord := range platform.rows.Find(func(state byte) bool { return state == ROUNDED_ROCK }) {
+		totalLoad += platform.rows.Height - rockCoord.Y
 	}
 
 	return totalLoad
 }
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	rowStrings := make([]string, 0)
-	for fileScanner.Scan() {
-		line := fileScanner.Text()
-		rowStrings = append(rowStrings, line)
-		log.Debug().
-			Str("ParsedLine", line).
-			Send()
-	}
+	return ProcessInputWithOptions(fileScanner, Options{})
+}
 
-	platform := NewPlatformData(rowStrings)
+func ProcessInputWithOptions(fileScanner *bufio.Scanner, options Options) (int, error) {
+	platform, err := NewPlatformData(fileScanner)
+	if err != nil {
+		return 0, err
+	}
 	platform.LogCurrentRows()
+	if options.Recorder != nil {
+		platform.OnStep = func(description string) {
+			options.Recorder.Record(description, func() string { return platform.rows.Format(formatPlatformCell) })
+		}
+		platform.step("Initial platform")
+	}
 
 	platform.PerformCycles(1_000_000_000)
 
-	// platform.LogCurrentRows()
+	if options.RenderPath != "" {
+		if err := platform.Render(options.RenderPath); err != nil {
+			return 0, err
+		}
+	}
+
 	totalLoad := platform.CalculateLoad()
 
 	return totalLoad, nil
@@ -0,0 +1,31 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+	"regexp"
+)
+
+const DAY = 15
+
+// A lens label followed by either removing the lens or a focal length to insert it with
+var stepRegexp = regexp.MustCompile(`^[a-z]+(-|=[0-9]+)$`)
+
+// Check the input is a single line of comma separated steps like "rn=1" or "cm-"
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+	lines := validate.ReadLines(fileScanner)
+
+	if len(lines) != 1 {
+		report.Addf(0, 0, "", "found %v lines, expected a single line of steps", len(lines))
+		return report.Violations
+	}
+	for _, step := range parse.Split(lines[0], ",", 1) {
+		if !stepRegexp.MatchString(step.Text) {
+			report.Addf(1, step.Column, step.Text, "expected a step like \"rn=1\" or \"cm-\"")
+		}
+	}
+
+	return report.Violations
+}
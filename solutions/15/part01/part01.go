@@ -2,7 +2,7 @@ package part01
 
 import (
 	"bufio"
-	"strings"
+	"hmcalister/aocCommon/input"
 
 	"github.com/rs/zerolog/log"
 )
@@ -20,16 +20,12 @@ func HASHAlgorithm(s string) int {
 }
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	fullText := ""
-	for fileScanner.Scan() {
-		line := fileScanner.Text()
-		fullText += line
-	}
-
-	fullTextFields := strings.Split(fullText, ",")
+	// The steps are one long line, so are read as tokens rather than as a single line
+	fields := input.Tokens(input.FromScanner(fileScanner), ',')
 
 	result := 0
-	for fieldIndex, field := range fullTextFields {
+	for fieldIndex := 0; fields.Next(); fieldIndex += 1 {
+		field := fields.Value()
 		fieldHash := HASHAlgorithm(field)
 
 		log.Debug().
@@ -41,5 +37,9 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 		result += fieldHash
 	}
 
+	if err := fields.Err(); err != nil {
+		return 0, err
+	}
+
 	return result, nil
 }
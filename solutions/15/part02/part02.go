@@ -2,12 +2,16 @@ package part02
 
 import (
 	"bufio"
+	"hmcalister/aocCommon/input"
+	"hmcalister/aocCommon/parse"
 	"strconv"
 	"strings"
 
 	"github.com/rs/zerolog/log"
 )
 
+const DAY = 15
+
 type LensData struct {
 	Identifier  string
 	FocalLength int
@@ -75,7 +79,8 @@ func (boxArray *BoxArrayData) addLens(label string, focalLen int) {
 	boxArray.Boxes[labelHash] = targetBox
 }
 
-func (boxArray *BoxArrayData) processField(field string) {
+// Apply the step given by the field. The steps are all on the first line of the input
+func (boxArray *BoxArrayData) processField(field string) error {
 	log.Trace().Str("Field", field).Msg("Processing Field")
 
 	var label string
@@ -87,12 +92,13 @@ func (boxArray *BoxArrayData) processField(field string) {
 		focalLenStr := field[strings.IndexRune(field, '=')+1:]
 		focalLen, err := strconv.Atoi(focalLenStr)
 		if err != nil {
-			log.Fatal().Msgf("could not parse given focal len %v from field %v to integer", focalLenStr, field)
+			return parse.Errorf(DAY, 1, 0, field, "could not parse focal length %q to integer", focalLenStr)
 		}
 		boxArray.addLens(label, focalLen)
 	} else {
-		log.Fatal().Msgf("field %v did not contain expected '-' or '='", field)
+		return parse.Errorf(DAY, 1, 0, field, "step did not contain expected '-' or '='")
 	}
+	return nil
 }
 
 func HASHAlgorithm(s string) int {
@@ -108,14 +114,17 @@ func HASHAlgorithm(s string) int {
 }
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	fileScanner.Scan()
-	fullText := fileScanner.Text()
-	fullTextFields := strings.Split(fullText, ",")
+	// The steps are one long line, so are read as tokens rather than as a single line
+	fields := input.Tokens(input.FromScanner(fileScanner), ',')
 
 	boxArray := NewBoxArray()
-
-	for _, field := range fullTextFields {
-		boxArray.processField(field)
+	for fields.Next() {
+		if err := boxArray.processField(fields.Value()); err != nil {
+			return 0, err
+		}
+	}
+	if err := fields.Err(); err != nil {
+		return 0, err
 	}
 
 	totalPower := 0
@@ -5,6 +5,7 @@ go 1.21.0
 require (
 	github.com/rs/zerolog v1.31.0
 	github.com/schollz/progressbar/v3 v3.14.1
+	hmcalister/aocCommon v0.0.0
 )
 
 require (
@@ -15,3 +16,5 @@ require (
 	golang.org/x/sys v0.14.0 // indirect
 	golang.org/x/term v0.14.0 // indirect
 )
+
+replace hmcalister/aocCommon => ../../common
@@ -1,48 +1,50 @@
 package lib
 
+import "hmcalister/aocCommon/grid"
+
 // Create a map detailing the resulting directions for a beam.
 //
-// The first map key, of type DirectionEnum, is the current direction of the light ray.
+// The first map key, of type grid.DirectionEnum, is the current direction of the light ray.
 //
 // The second may key, of type LayoutRuneEnum, is the current rune the light ray is encountering.
 //
-// The resulting output, of type []DirectionEnum, is a list of all the resulting light ray directions.
+// The resulting output, of type []grid.DirectionEnum, is a list of all the resulting light ray directions.
 // Note this is an array as it is possible (see, splitters) for one light ray to become multiple.
-func CreateDirectionMap() map[DirectionEnum]map[LayoutRuneEnum][]DirectionEnum {
-	NorthwardsRunes := map[LayoutRuneEnum][]DirectionEnum{
-		EMPTY_RUNE:           {DIRECTION_NORTH},
-		FORWARD_SLASH_MIRROR: {DIRECTION_EAST},
-		BACK_SLASH_MIRROR:    {DIRECTION_WEST},
-		VERTICAL_SPLITTER:    {DIRECTION_NORTH},
-		HORIZONTAL_SPLITTER:  {DIRECTION_WEST, DIRECTION_EAST},
+func CreateDirectionMap() map[grid.DirectionEnum]map[LayoutRuneEnum][]grid.DirectionEnum {
+	NorthwardsRunes := map[LayoutRuneEnum][]grid.DirectionEnum{
+		EMPTY_RUNE:           {grid.DIRECTION_UP},
+		FORWARD_SLASH_MIRROR: {grid.DIRECTION_RIGHT},
+		BACK_SLASH_MIRROR:    {grid.DIRECTION_LEFT},
+		VERTICAL_SPLITTER:    {grid.DIRECTION_UP},
+		HORIZONTAL_SPLITTER:  {grid.DIRECTION_LEFT, grid.DIRECTION_RIGHT},
 	}
-	EastwardsRunes := map[LayoutRuneEnum][]DirectionEnum{
-		EMPTY_RUNE:           {DIRECTION_EAST},
-		FORWARD_SLASH_MIRROR: {DIRECTION_NORTH},
-		BACK_SLASH_MIRROR:    {DIRECTION_SOUTH},
-		VERTICAL_SPLITTER:    {DIRECTION_NORTH, DIRECTION_SOUTH},
-		HORIZONTAL_SPLITTER:  {DIRECTION_EAST},
+	EastwardsRunes := map[LayoutRuneEnum][]grid.DirectionEnum{
+		EMPTY_RUNE:           {grid.DIRECTION_RIGHT},
+		FORWARD_SLASH_MIRROR: {grid.DIRECTION_UP},
+		BACK_SLASH_MIRROR:    {grid.DIRECTION_DOWN},
+		VERTICAL_SPLITTER:    {grid.DIRECTION_UP, grid.DIRECTION_DOWN},
+		HORIZONTAL_SPLITTER:  {grid.DIRECTION_RIGHT},
 	}
-	SouthwardsRunes := map[LayoutRuneEnum][]DirectionEnum{
-		EMPTY_RUNE:           {DIRECTION_SOUTH},
-		FORWARD_SLASH_MIRROR: {DIRECTION_WEST},
-		BACK_SLASH_MIRROR:    {DIRECTION_EAST},
-		VERTICAL_SPLITTER:    {DIRECTION_SOUTH},
-		HORIZONTAL_SPLITTER:  {DIRECTION_WEST, DIRECTION_EAST},
+	SouthwardsRunes := map[LayoutRuneEnum][]grid.DirectionEnum{
+		EMPTY_RUNE:           {grid.DIRECTION_DOWN},
+		FORWARD_SLASH_MIRROR: {grid.DIRECTION_LEFT},
+		BACK_SLASH_MIRROR:    {grid.DIRECTION_RIGHT},
+		VERTICAL_SPLITTER:    {grid.DIRECTION_DOWN},
+		HORIZONTAL_SPLITTER:  {grid.DIRECTION_LEFT, grid.DIRECTION_RIGHT},
 	}
-	WestwardsRunes := map[LayoutRuneEnum][]DirectionEnum{
-		EMPTY_RUNE:           {DIRECTION_WEST},
-		FORWARD_SLASH_MIRROR: {DIRECTION_SOUTH},
-		BACK_SLASH_MIRROR:    {DIRECTION_NORTH},
-		VERTICAL_SPLITTER:    {DIRECTION_NORTH, DIRECTION_SOUTH},
-		HORIZONTAL_SPLITTER:  {DIRECTION_WEST},
+	WestwardsRunes := map[LayoutRuneEnum][]grid.DirectionEnum{
+		EMPTY_RUNE:           {grid.DIRECTION_LEFT},
+		FORWARD_SLASH_MIRROR: {grid.DIRECTION_DOWN},
+		BACK_SLASH_MIRROR:    {grid.DIRECTION_UP},
+		VERTICAL_SPLITTER:    {grid.DIRECTION_UP, grid.DIRECTION_DOWN},
+		HORIZONTAL_SPLITTER:  {grid.DIRECTION_LEFT},
 	}
 
-	DirectionMap := map[DirectionEnum]map[LayoutRuneEnum][]DirectionEnum{
-		DIRECTION_NORTH: NorthwardsRunes,
-		DIRECTION_EAST:  EastwardsRunes,
-		DIRECTION_SOUTH: SouthwardsRunes,
-		DIRECTION_WEST:  WestwardsRunes,
+	DirectionMap := map[grid.DirectionEnum]map[LayoutRuneEnum][]grid.DirectionEnum{
+		grid.DIRECTION_UP:    NorthwardsRunes,
+		grid.DIRECTION_RIGHT: EastwardsRunes,
+		grid.DIRECTION_DOWN:  SouthwardsRunes,
+		grid.DIRECTION_LEFT:  WestwardsRunes,
 	}
 	return DirectionMap
 }
@@ -2,49 +2,43 @@ package lib
 
 import (
 	"bufio"
+	"fmt"
+	"hmcalister/aocCommon/grid"
+	"hmcalister/aocCommon/render"
 	"slices"
 
 	"github.com/rs/zerolog/log"
 )
 
+const DAY = 16
+
 type LayoutData struct {
-	Layout                     [][]LayoutRuneEnum
-	LineLength                 int
+	Layout                     *grid.Grid[LayoutRuneEnum]
 	EnergizedLinearCoordinates []int
 	ProcessedLightRayMap       map[string]interface{}
 	UnprocessedLightRays       []*LightRay
-	directionMap               map[DirectionEnum]map[LayoutRuneEnum][]DirectionEnum
+	directionMap               map[grid.DirectionEnum]map[LayoutRuneEnum][]grid.DirectionEnum
+
+	// If set, called after each light ray is processed with a description of the step
+	OnStep func(description string)
 }
 
-func CreateLayoutData(fileScanner *bufio.Scanner) [][]LayoutRuneEnum {
-	var line string
-	var lineLength int
-
-	layoutRunes := make([][]LayoutRuneEnum, 0)
-
-	for fileScanner.Scan() {
-		line = fileScanner.Text()
-		lineLength = len(line)
-		layoutRunes = append(layoutRunes, make([]LayoutRuneEnum, lineLength))
-
-		for runeIndex, r := range line {
-			layoutRune := LayoutRuneEnum(r)
-			log.Trace().
-				Int("LineIndex", len(layoutRunes)-1).
-				Int("RuneIndex", runeIndex).
-				Str("RuneFound", layoutRune.String()).
-				Send()
-			layoutRunes[len(layoutRunes)-1][runeIndex] = layoutRune
-		}
+func parseLayoutRune(r rune) (LayoutRuneEnum, error) {
+	layoutRune := LayoutRuneEnum(r)
+	switch layoutRune {
+	case EMPTY_RUNE, FORWARD_SLASH_MIRROR, BACK_SLASH_MIRROR, VERTICAL_SPLITTER, HORIZONTAL_SPLITTER:
+		return layoutRune, nil
 	}
+	return 0, fmt.Errorf("unknown layout rune %q", r)
+}
 
-	return layoutRunes
+func CreateLayoutData(fileScanner *bufio.Scanner) (*grid.Grid[LayoutRuneEnum], error) {
+	return grid.Parse(DAY, fileScanner, parseLayoutRune)
 }
 
-func NewLayoutData(layoutRunes [][]LayoutRuneEnum, initialLightRay *LightRay) *LayoutData {
+func NewLayoutData(layoutRunes *grid.Grid[LayoutRuneEnum], initialLightRay *LightRay) *LayoutData {
 	return &LayoutData{
 		Layout:                     layoutRunes,
-		LineLength:                 len(layoutRunes[0]),
 		EnergizedLinearCoordinates: make([]int, 0),
 		ProcessedLightRayMap:       make(map[string]interface{}),
 		UnprocessedLightRays:       []*LightRay{initialLightRay},
@@ -52,27 +46,18 @@ func NewLayoutData(layoutRunes [][]LayoutRuneEnum, initialLightRay *LightRay) *L
 	}
 }
 
-func (layout *LayoutData) CartesianToLinearCoordinate(x, y int) int {
-	return y*layout.LineLength + x
-}
-
-func (layout *LayoutData) LinearToCartesianCoordinate(l int) (int, int) {
-	return l % layout.LineLength, l / layout.LineLength
-}
-
 func (layout *LayoutData) processLightRay(currentRay *LightRay) {
-	currentRune := layout.Layout[currentRay.YCoord][currentRay.XCoord]
+	currentRune := layout.Layout.Get(currentRay.Coordinate)
 	nextDirections := layout.directionMap[currentRay.Direction][currentRune]
 	for _, dir := range nextDirections {
 		nextLightRay := &LightRay{
-			Direction: dir,
-			XCoord:    currentRay.XCoord,
-			YCoord:    currentRay.YCoord,
+			Direction:  dir,
+			Coordinate: currentRay.Coordinate,
 		}
 		nextLightRay.MarchRay()
 
 		// Ensure light ray is actually in the bounds of the layout
-		if nextLightRay.XCoord < 0 || nextLightRay.XCoord >= layout.LineLength || nextLightRay.YCoord < 0 || nextLightRay.YCoord >= len(layout.Layout) {
+		if !layout.Layout.InBounds(nextLightRay.Coordinate) {
 			continue
 		}
 
@@ -91,8 +76,11 @@ func (layout *LayoutData) ProcessLayout() {
 			continue
 		}
 		layout.ProcessedLightRayMap[currentRayStr] = currentRay
-		layout.EnergizedLinearCoordinates = append(layout.EnergizedLinearCoordinates, layout.CartesianToLinearCoordinate(currentRay.XCoord, currentRay.YCoord))
+		layout.EnergizedLinearCoordinates = append(layout.EnergizedLinearCoordinates, layout.Layout.LinearIndex(currentRay.Coordinate))
 		layout.processLightRay(currentRay)
+		if layout.OnStep != nil {
+			layout.OnStep(fmt.Sprintf("Light ray %v", currentRayStr))
+		}
 	}
 
 	slices.Sort(layout.EnergizedLinearCoordinates)
@@ -100,31 +88,45 @@ func (layout *LayoutData) ProcessLayout() {
 }
 
 func (layout *LayoutData) ShowLayout() {
-	for _, line := range layout.Layout {
+	for y := 0; y < layout.Layout.Height; y += 1 {
 		log.Info().
-			Str("Layout", string(line)).
+			Str("Layout", string(layout.Layout.Row(y))).
 			Send()
 	}
 }
 
 func (layout *LayoutData) ShowEnergizedCells() {
-	cells := make([][]LayoutRuneEnum, len(layout.Layout))
-	for lineIndex := range cells {
-		newLine := make([]LayoutRuneEnum, layout.LineLength)
-		for cellIndex := 0; cellIndex < layout.LineLength; cellIndex += 1 {
-			newLine[cellIndex] = priv_NON_ENERGIZED_RUNE
-		}
-		cells[lineIndex] = newLine
-	}
+	cells := grid.New[LayoutRuneEnum](layout.Layout.Width, layout.Layout.Height)
+	cells.Fill(priv_NON_ENERGIZED_RUNE)
 
 	for _, energizedLinearCoord := range layout.EnergizedLinearCoordinates {
-		x, y := layout.LinearToCartesianCoordinate(energizedLinearCoord)
-		cells[y][x] = priv_ENERGIZED_RUNE
+		cells.Set(cells.PointFromLinearIndex(energizedLinearCoord), priv_ENERGIZED_RUNE)
 	}
 
-	for _, line := range cells {
+	for y := 0; y < cells.Height; y += 1 {
 		log.Info().
-			Str("EnergizedCells", string(line)).
+			Str("EnergizedCells", string(cells.Row(y))).
 			Send()
 	}
 }
+
+// Format the layout with energized cells that have no mirror or splitter shown as priv_ENERGIZED_RUNE
+func (layout *LayoutData) FormatEnergizedCells() string {
+	cells := layout.Layout.Clone()
+	for _, energizedLinearCoord := range layout.EnergizedLinearCoordinates {
+		energizedCoord := cells.PointFromLinearIndex(energizedLinearCoord)
+		if cells.Get(energizedCoord) == EMPTY_RUNE {
+			cells.Set(energizedCoord, priv_ENERGIZED_RUNE)
+		}
+	}
+	return cells.Format(func(cell LayoutRuneEnum) rune { return rune(cell) })
+}
+
+// Render the layout to a PNG file, with the energized cells drawn over it
+func (layout *LayoutData) Render(filePath string) error {
+	canvas := render.Grid(layout.Layout, layoutPalette, render.DEFAULT_CELL_SIZE)
+	for _, energizedLinearCoord := range layout.EnergizedLinearCoordinates {
+		canvas.FillCell(layout.Layout.PointFromLinearIndex(energizedLinearCoord), energizedColor)
+	}
+	return canvas.WritePNG(filePath)
+}
@@ -1,5 +1,10 @@
 package lib
 
+import (
+	"hmcalister/aocCommon/render"
+	"image/color"
+)
+
 //go:generate stringer -type=LayoutRuneEnum
 type LayoutRuneEnum rune
 
@@ -13,3 +18,16 @@ const (
 	priv_NON_ENERGIZED_RUNE LayoutRuneEnum = '.'
 	priv_ENERGIZED_RUNE     LayoutRuneEnum = '#'
 )
+
+var (
+	layoutPalette = render.Palette[LayoutRuneEnum]{
+		EMPTY_RUNE:           color.RGBA{0x20, 0x20, 0x30, 0xff},
+		FORWARD_SLASH_MIRROR: color.RGBA{0x60, 0xa0, 0xe0, 0xff},
+		BACK_SLASH_MIRROR:    color.RGBA{0x60, 0xa0, 0xe0, 0xff},
+		VERTICAL_SPLITTER:    color.RGBA{0xe0, 0x60, 0x60, 0xff},
+		HORIZONTAL_SPLITTER:  color.RGBA{0xe0, 0x60, 0x60, 0xff},
+	}
+
+	// Energized cells are drawn translucent, so the mirrors and splitters beneath still show
+	energizedColor = color.RGBA{0xff, 0xd0, 0x00, 0xa0}
+)
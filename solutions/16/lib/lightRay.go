@@ -1,26 +1,19 @@
 package lib
 
-import "fmt"
+import (
+	"fmt"
+	"hmcalister/aocCommon/grid"
+)
 
 type LightRay struct {
-	Direction DirectionEnum
-	XCoord    int
-	YCoord    int
+	Direction  grid.DirectionEnum
+	Coordinate grid.Point
 }
 
 func (ray *LightRay) String() string {
-	return fmt.Sprintf("%v (%v, %v)", ray.Direction.String(), ray.XCoord, ray.YCoord)
+	return fmt.Sprintf("%v %v", ray.Direction.String(), ray.Coordinate.String())
 }
 
 func (ray *LightRay) MarchRay() {
-	switch ray.Direction {
-	case DIRECTION_NORTH:
-		ray.YCoord -= 1
-	case DIRECTION_EAST:
-		ray.XCoord += 1
-	case DIRECTION_SOUTH:
-		ray.YCoord += 1
-	case DIRECTION_WEST:
-		ray.XCoord -= 1
-	}
+	ray.Coordinate = ray.Coordinate.Move(ray.Direction)
 }
@@ -0,0 +1,18 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+)
+
+// Check the layout is rectangular and made of empty space, mirrors, and splitters
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+
+	if _, err := CreateLayoutData(fileScanner); err != nil {
+		report.Add(err)
+	}
+
+	return report.Violations
+}
@@ -3,24 +3,51 @@ package part01
 import (
 	"bufio"
 	"hmcalister/aoc16/lib"
+	"hmcalister/aocCommon/grid"
+	"hmcalister/aocCommon/stepview"
 
 	"github.com/rs/zerolog/log"
 )
 
+// Optional outputs of ProcessInputWithOptions, beyond the answer
+type Options struct {
+	// If not empty, render the layout and its energized cells to a PNG file at this path
+	RenderPath string
+	// If not nil, record the energized cells after each light ray is processed to it
+	Recorder *stepview.Recorder
+}
+
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	layoutRunes := lib.CreateLayoutData(fileScanner)
+	return ProcessInputWithOptions(fileScanner, Options{})
+}
+
+func ProcessInputWithOptions(fileScanner *bufio.Scanner, options Options) (int, error) {
+	layoutRunes, err := lib.CreateLayoutData(fileScanner)
+	if err != nil {
+		return 0, err
+	}
 
 	layout := lib.NewLayoutData(layoutRunes, &lib.LightRay{
-		Direction: lib.DIRECTION_EAST,
-		XCoord:    0,
-		YCoord:    0,
+		Direction:  grid.DIRECTION_RIGHT,
+		Coordinate: grid.Point{X: 0, Y: 0},
 	})
 	layout.ShowLayout()
+	if options.Recorder != nil {
+		layout.OnStep = func(description string) {
+			options.Recorder.Record(description, layout.FormatEnergizedCells)
+		}
+	}
 
 	layout.ProcessLayout()
 
 	log.Debug().Interface("EnergizedLinearCoords", layout.EnergizedLinearCoordinates).Send()
 	layout.ShowEnergizedCells()
 
+	if options.RenderPath != "" {
+		if err := layout.Render(options.RenderPath); err != nil {
+			return 0, err
+		}
+	}
+
 	return len(layout.EnergizedLinearCoordinates), nil
 }
@@ -3,70 +3,78 @@ package part02
 import (
 	"bufio"
 	"hmcalister/aoc16/lib"
+	"hmcalister/aocCommon/grid"
+	"hmcalister/aocCommon/stepview"
 	"math"
 
 	"github.com/schollz/progressbar/v3"
 )
 
+// Optional outputs of ProcessInputWithOptions, beyond the answer
+type Options struct {
+	// If not empty, render the layout and the cells energized by the best
+	// entry point to a PNG file at this path
+	RenderPath string
+	// If not nil, record the energized cells after each light ray is processed to it,
+	// for the entry point that energizes the most cells
+	Recorder *stepview.Recorder
+}
+
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
+	return ProcessInputWithOptions(fileScanner, Options{})
+}
+
+func ProcessInputWithOptions(fileScanner *bufio.Scanner, options Options) (int, error) {
 	var pbar *progressbar.ProgressBar
 
-	layoutRunes := lib.CreateLayoutData(fileScanner)
-	yLim := len(layoutRunes)
-	xLim := len(layoutRunes[0])
+	layoutRunes, err := lib.CreateLayoutData(fileScanner)
+	if err != nil {
+		return 0, err
+	}
 
 	highestEnergizedVal := math.MinInt
+	var highestEnergizedLayout *lib.LayoutData
+	var highestEnergizedInitialRay lib.LightRay
 
-	// Check north edge
-	pbar = progressbar.Default(int64(xLim), "North Edge")
-	for x := 0; x < xLim; x += 1 {
-		layout := lib.NewLayoutData(layoutRunes, &lib.LightRay{
-			Direction: lib.DIRECTION_SOUTH,
-			XCoord:    x,
-			YCoord:    0,
-		})
-		layout.ProcessLayout()
-		highestEnergizedVal = max(highestEnergizedVal, len(layout.EnergizedLinearCoordinates))
-		pbar.Add(1)
+	// Check each edge, with the light ray entering the layout away from that edge
+	edgeNames := map[grid.DirectionEnum]string{
+		grid.DIRECTION_UP:    "North Edge",
+		grid.DIRECTION_RIGHT: "East Edge",
+		grid.DIRECTION_DOWN:  "South Edge",
+		grid.DIRECTION_LEFT:  "West Edge",
 	}
-
-	// Check east edge
-	pbar = progressbar.Default(int64(yLim), "East Edge")
-	for y := 0; y < yLim; y += 1 {
-		layout := lib.NewLayoutData(layoutRunes, &lib.LightRay{
-			Direction: lib.DIRECTION_WEST,
-			XCoord:    xLim - 1,
-			YCoord:    y,
-		})
-		layout.ProcessLayout()
-		highestEnergizedVal = max(highestEnergizedVal, len(layout.EnergizedLinearCoordinates))
-		pbar.Add(1)
+	for _, edgeDirection := range grid.CARDINAL_DIRECTIONS {
+		edge := layoutRunes.Edge(edgeDirection)
+		pbar = progressbar.Default(int64(len(edge)), edgeNames[edgeDirection])
+		for _, edgeCoord := range edge {
+			initialRay := lib.LightRay{
+				Direction:  edgeDirection.Opposite(),
+				Coordinate: edgeCoord,
+			}
+			layout := lib.NewLayoutData(layoutRunes, &initialRay)
+			layout.ProcessLayout()
+			if len(layout.EnergizedLinearCoordinates) > highestEnergizedVal {
+				highestEnergizedVal = len(layout.EnergizedLinearCoordinates)
+				highestEnergizedLayout = layout
+				highestEnergizedInitialRay = initialRay
+			}
+			pbar.Add(1)
+		}
 	}
 
-	// Check south edge
-	pbar = progressbar.Default(int64(xLim), "South Edge")
-	for x := 0; x < xLim; x += 1 {
-		layout := lib.NewLayoutData(layoutRunes, &lib.LightRay{
-			Direction: lib.DIRECTION_NORTH,
-			XCoord:    x,
-			YCoord:    yLim - 1,
-		})
+	// Recording every entry point would fill the recorder with the first few, so process the best one again
+	if options.Recorder != nil {
+		layout := lib.NewLayoutData(layoutRunes, &highestEnergizedInitialRay)
+		layout.OnStep = func(description string) {
+			options.Recorder.Record(description, layout.FormatEnergizedCells)
+		}
 		layout.ProcessLayout()
-		highestEnergizedVal = max(highestEnergizedVal, len(layout.EnergizedLinearCoordinates))
-		pbar.Add(1)
 	}
 
-	// Check west edge
-	pbar = progressbar.Default(int64(yLim), "West Edge")
-	for y := 0; y < yLim; y += 1 {
-		layout := lib.NewLayoutData(layoutRunes, &lib.LightRay{
-			Direction: lib.DIRECTION_EAST,
-			XCoord:    0,
-			YCoord:    y,
-		})
-		layout.ProcessLayout()
-		highestEnergizedVal = max(highestEnergizedVal, len(layout.EnergizedLinearCoordinates))
-		pbar.Add(1)
+	if options.RenderPath != "" {
+		if err := highestEnergizedLayout.Render(options.RenderPath); err != nil {
+			return 0, err
+		}
 	}
 
 	return highestEnergizedVal, nil
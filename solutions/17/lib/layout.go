@@ -2,109 +2,98 @@ package lib
 
 import (
 	"bufio"
-	"container/heap"
-	"strconv"
-	"strings"
+	"fmt"
+	"hmcalister/aocCommon/graphalg"
+	"hmcalister/aocCommon/grid"
 
 	"github.com/rs/zerolog/log"
 )
 
+const DAY = 17
+
 type LayoutData struct {
-	CostMap       [][]int
-	MapWidth      int
-	MapHeight     int
+	CostMap       *grid.Grid[int]
 	minPathStreak int
 	maxPathStreak int
 }
 
-func NewLayoutFromFileScanner(fileScanner *bufio.Scanner, minPathStreak int, maxPathStreak int) *LayoutData {
-	costMap := make([][]int, 0)
-	for fileScanner.Scan() {
-		lineStr := fileScanner.Text()
-		lineFields := strings.Split(lineStr, "")
-		costLine := make([]int, len(lineFields))
+func parseCostRune(r rune) (int, error) {
+	if r < '0' || '9' < r {
+		return 0, fmt.Errorf("expected digit, found %q", r)
+	}
+	return int(r - '0'), nil
+}
 
-		for index, field := range lineFields {
-			parsedInt, ok := strconv.Atoi(field)
-			if ok != nil {
-				log.Fatal().Msgf("failed to parse field %v: %v in line %v", index, field, lineStr)
-			}
-			costLine[index] = parsedInt
-		}
-		log.Debug().Interface("CostMapLine", costLine).Send()
-		costMap = append(costMap, costLine)
+func NewLayoutFromFileScanner(fileScanner *bufio.Scanner, minPathStreak int, maxPathStreak int) (*LayoutData, error) {
+	costMap, err := grid.Parse(DAY, fileScanner, parseCostRune)
+	if err != nil {
+		return nil, err
+	}
+	for y := 0; y < costMap.Height; y += 1 {
+		log.Debug().Interface("CostMapLine", costMap.Row(y)).Send()
 	}
 
 	return &LayoutData{
 		CostMap:       costMap,
-		MapWidth:      len(costMap[0]),
-		MapHeight:     len(costMap),
 		minPathStreak: minPathStreak,
 		maxPathStreak: maxPathStreak,
-	}
-}
-
-func (layout *LayoutData) checkValidCoordinate(coord coordinate) bool {
-	return (0 <= coord.X && coord.X < layout.MapWidth) && (0 <= coord.Y && coord.Y < layout.MapHeight)
+	}, nil
 }
 
 func (layout *LayoutData) checkGoalNode(node pathFindNodeData) bool {
-	return node.Coordinate.X == layout.MapWidth-1 && node.Coordinate.Y == layout.MapHeight-1
+	return node.Coordinate.X == layout.CostMap.Width-1 && node.Coordinate.Y == layout.CostMap.Height-1
 }
 
-func (layout *LayoutData) PathFind() int {
-	visited := make(map[string]pathFindNodeData)
-	priorityQueue := make(PriorityQueue, 0)
-	heap.Push(&priorityQueue, newPathFindNode(coordinate{1, 0}, DIRECTION_RIGHT, 0, layout.CostMap[0][1]))
-	heap.Push(&priorityQueue, newPathFindNode(coordinate{0, 1}, DIRECTION_DOWN, 0, layout.CostMap[1][0]))
+// The states reachable from a node in one step, weighted by the heat lost entering them
+func (layout *LayoutData) Neighbors(currentNode pathFindNodeData) []graphalg.Edge[pathFindNodeData] {
+	log.Debug().Str("CurrentNode", currentNode.String()).Send()
+	edges := make([]graphalg.Edge[pathFindNodeData], 0, 3)
 
-	for priorityQueue.Len() > 0 {
-		currentNode := heap.Pop(&priorityQueue).(pathFindNodeData)
-		log.Debug().Str("CurrentNode", currentNode.String()).Send()
-		// If we are at the goal and have a valid streak length, we are done
-		if layout.checkGoalNode(currentNode) && layout.minPathStreak <= currentNode.Streak {
-			return currentNode.Cost
-		}
-		// If we have already seen this node, we can skip it
-		if _, ok := visited[currentNode.Hash()]; ok {
-			continue
+	// If we have not yet exhausted this streak, continue on to the next node
+	nextCoord := currentNode.Coordinate.Move(currentNode.Direction)
+	if nextCost, ok := layout.CostMap.Lookup(nextCoord); ok && currentNode.Streak < layout.maxPathStreak-1 {
+		nextNode := newPathFindNode(nextCoord, currentNode.Direction, currentNode.Streak+1)
+		edges = append(edges, graphalg.Edge[pathFindNodeData]{To: nextNode, Weight: nextCost})
+		log.Trace().Interface("ConsideringNode", nextNode).Send()
+	}
+	// If we have continued on this path long enough, consider turning as well
+	if layout.minPathStreak <= currentNode.Streak {
+		turnDirections := []grid.DirectionEnum{
+			currentNode.Direction.TurnCounterClockwise(),
+			currentNode.Direction.TurnClockwise(),
 		}
-		visited[currentNode.Hash()] = currentNode
 
-		// If we have not yet exhausted this streak, add the next node to the queue
-		nextCoord := currentNode.Coordinate.Move(currentNode.Direction)
-		if currentNode.Streak < layout.maxPathStreak-1 && layout.checkValidCoordinate(nextCoord) {
-			nextCost := currentNode.Cost + layout.CostMap[nextCoord.Y][nextCoord.X]
-			nextNode := newPathFindNode(nextCoord, currentNode.Direction, currentNode.Streak+1, nextCost)
-			heap.Push(&priorityQueue, nextNode)
+		for _, nextDirection := range turnDirections {
+			nextCoord := currentNode.Coordinate.Move(nextDirection)
+			nextCost, ok := layout.CostMap.Lookup(nextCoord)
+			if !ok {
+				continue
+			}
+			nextNode := newPathFindNode(nextCoord, nextDirection, 0)
+			edges = append(edges, graphalg.Edge[pathFindNodeData]{To: nextNode, Weight: nextCost})
 			log.Trace().Interface("ConsideringNode", nextNode).Send()
 		}
-		// If we have continued on this path long enough, consider turning as well
-		if layout.minPathStreak <= currentNode.Streak {
-			var turnDirections []DirectionEnum
-			switch currentNode.Direction {
-			case DIRECTION_UP:
-				fallthrough
-			case DIRECTION_DOWN:
-				turnDirections = []DirectionEnum{DIRECTION_LEFT, DIRECTION_RIGHT}
-			case DIRECTION_LEFT:
-				fallthrough
-			case DIRECTION_RIGHT:
-				turnDirections = []DirectionEnum{DIRECTION_UP, DIRECTION_DOWN}
-			}
+	}
+	return edges
+}
 
-			for _, nextDirection := range turnDirections {
-				nextCoord := currentNode.Coordinate.Move(nextDirection)
-				if !layout.checkValidCoordinate(nextCoord) {
-					continue
-				}
-				nextCost := currentNode.Cost + layout.CostMap[nextCoord.Y][nextCoord.X]
-				nextNode := newPathFindNode(nextCoord, nextDirection, 0, nextCost)
-				heap.Push(&priorityQueue, nextNode)
-				log.Trace().Interface("ConsideringNode", nextNode).Send()
-			}
-		}
+// Find the least heat lost getting from the top left to the bottom right, or 0 if it cannot be reached
+func (layout *LayoutData) PathFind() int {
+	// Start on the top left heading right or down, with a streak of -1 so the first
+	// step in that direction has a streak of 0 and is not yet allowed to turn
+	startNodes := make([]pathFindNodeData, 0)
+	for _, startDirection := range []grid.DirectionEnum{grid.DIRECTION_RIGHT, grid.DIRECTION_DOWN} {
+		startNodes = append(startNodes, newPathFindNode(grid.Point{X: 0, Y: 0}, startDirection, -1))
 	}
 
-	return 0
+	// If we are at the goal and have a valid streak length, we are done
+	isGoal := func(node pathFindNodeData) bool {
+		return layout.checkGoalNode(node) && layout.minPathStreak <= node.Streak
+	}
+	path, ok := graphalg.Dijkstra[pathFindNodeData](layout, startNodes, isGoal)
+	if !ok {
+		return 0
+	}
+	log.Debug().Stringer("GoalNode", path.States[len(path.States)-1]).Send()
+	return path.Cost
 }
@@ -0,0 +1,19 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+)
+
+// Check the map is rectangular and made of single digit heat losses
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+
+	// The streak limits only matter when searching, so any will do
+	if _, err := NewLayoutFromFileScanner(fileScanner, 0, 0); err != nil {
+		report.Add(err)
+	}
+
+	return report.Violations
+}
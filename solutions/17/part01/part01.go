@@ -6,7 +6,10 @@ import (
 )
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	layout := lib.NewLayoutFromFileScanner(fileScanner, 0, 3)
+	layout, err := lib.NewLayoutFromFileScanner(fileScanner, 0, 3)
+	if err != nil {
+		return 0, err
+	}
 	goalDist := layout.PathFind()
 	return goalDist, nil
 }
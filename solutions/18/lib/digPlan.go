@@ -0,0 +1,33 @@
+package lib
+
+import "hmcalister/aocCommon/parse"
+
+// A step of the dig plan, like the example: R 6 (#70c710)
+type DigPlanLine struct {
+	// One of U, D, L or R
+	Direction string
+	Distance  int
+	// The color with its leading #, like #70c710
+	Color string
+}
+
+// Parse a step of the dig plan. Part 1 reads the direction and distance, and part 2 decodes both from the color
+func ParseDigPlanLine(line string, lineNumber int) (DigPlanLine, error) {
+	if !digPlanLineRegexp.MatchString(line) {
+		return DigPlanLine{}, parse.Errorf(DAY, lineNumber, 1, line, "expected dig plan line of the form \"R 6 (#70c710)\"")
+	}
+
+	fields := parse.Fields(line, 1)
+	distance, err := parse.Atoi(DAY, lineNumber, fields[1])
+	if err != nil {
+		return DigPlanLine{}, err
+	}
+	// Take off parentheses
+	colorField := fields[2].Text
+
+	return DigPlanLine{
+		Direction: fields[0].Text,
+		Distance:  distance,
+		Color:     colorField[1 : len(colorField)-1],
+	}, nil
+}
@@ -0,0 +1,36 @@
+package lib
+
+import (
+	"fmt"
+	"hmcalister/aocCommon/golden"
+	"testing"
+)
+
+func FuzzParseDigPlanLine(f *testing.F) {
+	for _, dir := range []string{"../part01/" + golden.TESTDATA_DIR, "../part02/" + golden.TESTDATA_DIR} {
+		lines, err := golden.ExampleLines(dir)
+		if err != nil {
+			f.Fatal(err)
+		}
+		for _, line := range lines {
+			f.Add(line)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, line string) {
+		digPlanLine, err := ParseDigPlanLine(line, 1)
+		if err != nil {
+			golden.CheckParseError(t, line, err)
+			return
+		}
+
+		printed := fmt.Sprintf("%v %v (%v)", digPlanLine.Direction, digPlanLine.Distance, digPlanLine.Color)
+		reparsed, err := ParseDigPlanLine(printed, 1)
+		if err != nil {
+			t.Fatalf("parsed %q but failed to parse it printed as %q: %v", line, printed, err)
+		}
+		if reparsed != digPlanLine {
+			t.Errorf("parsed %q and %q to different steps", line, printed)
+		}
+	})
+}
@@ -0,0 +1,30 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+	"regexp"
+)
+
+const DAY = 18
+
+// Part 1 reads the direction and distance, and part 2 decodes them from the color
+var digPlanLineRegexp = regexp.MustCompile(`^[UDLR] [0-9]+ \(#[0-9a-f]{5}[0-3]\)$`)
+
+// Check each line is a step of the dig plan like "R 6 (#70c710)"
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+	lines := validate.ReadLines(fileScanner)
+
+	if len(lines) == 0 {
+		report.Addf(0, 0, "", "input is empty")
+	}
+	for lineIndex, line := range lines {
+		if _, err := ParseDigPlanLine(line, lineIndex+1); err != nil {
+			report.Add(err)
+		}
+	}
+
+	return report.Violations
+}
@@ -2,12 +2,19 @@ package lib
 
 import (
 	"bufio"
-	"strconv"
-	"strings"
+	day18lib "hmcalister/aoc18/lib"
+	"hmcalister/aocCommon/grid"
+	"hmcalister/aocCommon/render"
+	"image/color"
 
 	"github.com/rs/zerolog/log"
 )
 
+var (
+	// The color of the excavated interior, as only the trench edges are painted
+	interiorColor = color.RGBA{0x40, 0x30, 0x20, 0xff}
+)
+
 type DigLayoutData struct {
 	// A map from coordinate to the trench at that coordinate
 	DigMap map[coordinate]TrenchData
@@ -29,28 +36,26 @@ type DigLayoutData struct {
 // # The number of spaces to be dug
 //
 // And the color to paint the trench edges
-func parseLineData(line string) (DirectionEnum, int, ColorData) {
-	fields := strings.Fields(line)
-
-	trenchDirection := directionDecoderMap[fields[0]]
-	numSpaces, err := strconv.Atoi(fields[1])
+func parseLineData(line string, lineNumber int) (DirectionEnum, int, ColorData, error) {
+	digPlanLine, err := day18lib.ParseDigPlanLine(line, lineNumber)
 	if err != nil {
-		log.Fatal().Msgf("failed to parse number of spaces %v in line %v", fields[1], line)
+		return DIRECTION_NONE, 0, ColorData{}, err
 	}
-	// Take off parentheses
-	colorString := fields[2][1 : len(fields[2])-1]
-	color := ColorData{ColorString: colorString}
+	trenchDirection := directionDecoderMap[digPlanLine.Direction]
+	numSpaces := digPlanLine.Distance
+	color := ColorData{ColorString: digPlanLine.Color}
 
 	log.Trace().
 		Str("RawLine", line).
 		Str("ParsedDirection", trenchDirection.String()).
 		Int("ParsedSpaces", numSpaces).
-		Interface("ParsedColor", color)
+		Interface("ParsedColor", color).
+		Send()
 
-	return trenchDirection, numSpaces, color
+	return trenchDirection, numSpaces, color, nil
 }
 
-func NewDigLayoutFromFileScanner(fileScanner *bufio.Scanner) *DigLayoutData {
+func NewDigLayoutFromFileScanner(fileScanner *bufio.Scanner) (*DigLayoutData, error) {
 	var line string
 	var currentCoordinate coordinate
 	var previousTrenchStretchEndCoordinate coordinate
@@ -72,9 +77,14 @@ func NewDigLayoutFromFileScanner(fileScanner *bufio.Scanner) *DigLayoutData {
 	}
 
 	// Each line in the file corresponds to a straight trench in the dig
+	lineNumber := 0
 	for fileScanner.Scan() {
+		lineNumber += 1
 		line = fileScanner.Text()
-		trenchDirection, numSpaces, color := parseLineData(line)
+		trenchDirection, numSpaces, color, err := parseLineData(line, lineNumber)
+		if err != nil {
+			return nil, err
+		}
 		trench := newTrench(trenchDirection, digLayout.CurrentDepth, color)
 
 		// Move along the trench and update the digmap as we go
@@ -94,7 +104,7 @@ func NewDigLayoutFromFileScanner(fileScanner *bufio.Scanner) *DigLayoutData {
 		digLayout.DigMap[previousTrenchStretchEndCoordinate] = previousStretchEnd.updateEdgeColors(trenchDirection, color)
 	}
 
-	return digLayout
+	return digLayout, nil
 }
 
 func (digLayout *DigLayoutData) VisualizeDigLayout() {
@@ -127,6 +137,28 @@ func (digLayout *DigLayoutData) VisualizeDigLayout() {
 	}
 }
 
+// Render the dig layout to a PNG file, drawing each trench in the color of one of its edges
+func (digLayout *DigLayoutData) Render(filePath string) error {
+	canvas := render.NewCanvas(digLayout.XMax-digLayout.XMin, digLayout.YMax-digLayout.YMin, render.DEFAULT_CELL_SIZE)
+	for trenchCoordinate, trench := range digLayout.DigMap {
+		cellColor := interiorColor
+		for _, direction := range []DirectionEnum{DIRECTION_UP, DIRECTION_RIGHT, DIRECTION_DOWN, DIRECTION_LEFT} {
+			edgeColor, ok := trench.EdgeColors[direction]
+			if !ok {
+				continue
+			}
+			parsedColor, err := render.ParseHexColor(edgeColor.ColorString)
+			if err != nil {
+				return err
+			}
+			cellColor = parsedColor
+			break
+		}
+		canvas.FillCell(grid.Point{X: trenchCoordinate.X - digLayout.XMin, Y: trenchCoordinate.Y - digLayout.YMin}, cellColor)
+	}
+	return canvas.WritePNG(filePath)
+}
+
 func (digLayout *DigLayoutData) ExcavateInterior() {
 	var isInterior bool
 
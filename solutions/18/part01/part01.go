@@ -7,8 +7,21 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+// Optional outputs of ProcessInputWithOptions, beyond the answer
+type Options struct {
+	// If not empty, render the excavated dig layout to a PNG file at this path
+	RenderPath string
+}
+
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	digLayout := lib.NewDigLayoutFromFileScanner(fileScanner)
+	return ProcessInputWithOptions(fileScanner, Options{})
+}
+
+func ProcessInputWithOptions(fileScanner *bufio.Scanner, options Options) (int, error) {
+	digLayout, err := lib.NewDigLayoutFromFileScanner(fileScanner)
+	if err != nil {
+		return 0, err
+	}
 
 	log.Info().Msg("Trench Before Excavation")
 	digLayout.VisualizeDigLayout()
@@ -18,6 +31,12 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 	log.Info().Msg("Trench AfterExcavation")
 	digLayout.VisualizeDigLayout()
 
+	if options.RenderPath != "" {
+		if err := digLayout.Render(options.RenderPath); err != nil {
+			return 0, err
+		}
+	}
+
 	totalVolume := digLayout.CalculateTotalVolume()
 
 	return totalVolume, nil
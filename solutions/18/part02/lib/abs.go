@@ -7,3 +7,14 @@ func abs(x int) int {
 
 	return x
 }
+
+func sign(x int) int {
+	if x < 0 {
+		return -1
+	}
+	if x > 0 {
+		return 1
+	}
+
+	return 0
+}
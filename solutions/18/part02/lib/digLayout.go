@@ -2,12 +2,25 @@ package lib
 
 import (
 	"bufio"
+	day18lib "hmcalister/aoc18/lib"
+	"hmcalister/aocCommon/grid"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/render"
+	"image/color"
 	"strconv"
-	"strings"
 
 	"github.com/rs/zerolog/log"
 )
 
+const DAY = 18
+
+// The largest width or height of a rendered dig layout in pixels, as the trenches span millions of cells
+const MAX_RENDER_SIZE = 1024
+
+var (
+	trenchColor = color.RGBA{0xe0, 0x80, 0x30, 0xff}
+)
+
 type DigLayoutData struct {
 	// The coordinate of each trench turn
 	trenchCoordinates []coordinate
@@ -17,25 +30,28 @@ type DigLayoutData struct {
 	YLim int
 }
 
-// Parse an individual line from the input to the corresponding information
+// Parse an individual line from the input to the corresponding information,
+// both of which are hex encoded in the color like (#70c710)
 //
 // # The direction the trench is to be dug
 //
 // # The number of spaces to be dug
-func parseLineData(line string) (DirectionEnum, int) {
-	fields := strings.Fields(line)
+func parseLineData(line string, lineNumber int) (DirectionEnum, int, error) {
+	digPlanLine, err := day18lib.ParseDigPlanLine(line, lineNumber)
+	if err != nil {
+		return DIRECTION_NONE, 0, err
+	}
 
-	// Take off parentheses and leading #
-	colorString := fields[2][2 : len(fields[2])-1]
+	// Take off the leading #
+	colorString := digPlanLine.Color[1:]
 
 	distanceStr := colorString[:5]
 	directionStr := colorString[5:]
 
 	trenchDirection := directionDecoderMap[directionStr]
-
 	numSpaces64, err := strconv.ParseInt(distanceStr, 16, 0)
 	if err != nil {
-		log.Fatal().Msgf("failed to parse hex encoded distance string %v in line %v", distanceStr, line)
+		return DIRECTION_NONE, 0, parse.NewError(DAY, lineNumber, 0, line, err)
 	}
 	numSpaces := int(numSpaces64)
 
@@ -45,10 +61,10 @@ func parseLineData(line string) (DirectionEnum, int) {
 		Int("ParsedSpaces", numSpaces).
 		Send()
 
-	return trenchDirection, numSpaces
+	return trenchDirection, numSpaces, nil
 }
 
-func NewDigLayoutFromFileScanner(fileScanner *bufio.Scanner) *DigLayoutData {
+func NewDigLayoutFromFileScanner(fileScanner *bufio.Scanner) (*DigLayoutData, error) {
 	var line string
 	var currentCoordinate coordinate
 
@@ -63,9 +79,14 @@ func NewDigLayoutFromFileScanner(fileScanner *bufio.Scanner) *DigLayoutData {
 	// digLayout.trenchCoordinates = append(digLayout.trenchCoordinates, currentCoordinate)
 
 	// Parse each line in the file, creating new trenches as we go
+	lineNumber := 0
 	for fileScanner.Scan() {
+		lineNumber += 1
 		line = fileScanner.Text()
-		trenchDirection, trenchLength := parseLineData(line)
+		trenchDirection, trenchLength, err := parseLineData(line, lineNumber)
+		if err != nil {
+			return nil, err
+		}
 		log.Debug().
 			Str("TrenchDirection", trenchDirection.String()).
 			Int("TrenchLength", trenchLength).
@@ -78,7 +99,7 @@ func NewDigLayoutFromFileScanner(fileScanner *bufio.Scanner) *DigLayoutData {
 		digLayout.trenchCoordinates = append(digLayout.trenchCoordinates, currentCoordinate)
 	}
 
-	return digLayout
+	return digLayout, nil
 }
 
 func (digLayout *DigLayoutData) updateLimitsAndRowStretches(newCoordinate coordinate) {
@@ -100,6 +121,34 @@ func (digLayout *DigLayoutData) VisualizeDigLayout() {
 	}
 }
 
+// Render the outline of the trench to a PNG file, scaled down so each pixel covers a square of cells
+func (digLayout *DigLayoutData) Render(filePath string) error {
+	xMin, xMax, yMin, yMax := 0, 0, 0, 0
+	for _, trenchCoordinate := range digLayout.trenchCoordinates {
+		xMin, xMax = min(xMin, trenchCoordinate.X), max(xMax, trenchCoordinate.X)
+		yMin, yMax = min(yMin, trenchCoordinate.Y), max(yMax, trenchCoordinate.Y)
+	}
+	scale := max((xMax-xMin)/MAX_RENDER_SIZE, (yMax-yMin)/MAX_RENDER_SIZE) + 1
+	scaleCoordinate := func(c coordinate) grid.Point {
+		return grid.Point{X: (c.X - xMin) / scale, Y: (c.Y - yMin) / scale}
+	}
+
+	canvas := render.NewCanvas((xMax-xMin)/scale+1, (yMax-yMin)/scale+1, 1)
+	// The trench starts and ends at the origin
+	previousPoint := scaleCoordinate(coordinate{0, 0})
+	for _, trenchCoordinate := range digLayout.trenchCoordinates {
+		nextPoint := scaleCoordinate(trenchCoordinate)
+		// Each stretch of trench is straight, so only one of these steps is not zero
+		step := grid.Point{X: sign(nextPoint.X - previousPoint.X), Y: sign(nextPoint.Y - previousPoint.Y)}
+		for currentPoint := previousPoint; currentPoint != nextPoint; currentPoint = currentPoint.Add(step) {
+			canvas.FillCell(currentPoint, trenchColor)
+		}
+		canvas.FillCell(nextPoint, trenchColor)
+		previousPoint = nextPoint
+	}
+	return canvas.WritePNG(filePath)
+}
+
 func (digLayout *DigLayoutData) CalculateTotalVolume() int {
 	totalArea := 0
 
@@ -5,9 +5,28 @@ import (
 	"hmcalister/aoc18/part02/lib"
 )
 
+// Optional outputs of ProcessInputWithOptions, beyond the answer
+type Options struct {
+	// If not empty, render the outline of the trench, scaled down, to a PNG file at this path
+	RenderPath string
+}
+
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	digLayout := lib.NewDigLayoutFromFileScanner(fileScanner)
+	return ProcessInputWithOptions(fileScanner, Options{})
+}
+
+func ProcessInputWithOptions(fileScanner *bufio.Scanner, options Options) (int, error) {
+	digLayout, err := lib.NewDigLayoutFromFileScanner(fileScanner)
+	if err != nil {
+		return 0, err
+	}
 	digLayout.VisualizeDigLayout()
+	if options.RenderPath != "" {
+		if err := digLayout.Render(options.RenderPath); err != nil {
+			return 0, err
+		}
+	}
+
 	totalVolume := digLayout.CalculateTotalVolume()
 
 	return totalVolume, nil
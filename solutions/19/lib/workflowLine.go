@@ -0,0 +1,129 @@
+package lib
+
+import (
+	"errors"
+	"hmcalister/aocCommon/parse"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+const DAY = 19
+
+// Failures of following the workflows from "in", shared by both parts
+var (
+	ErrNoEntryWorkflow = errors.New("no entry point workflow with label \"in\"")
+	ErrUnknownWorkflow = errors.New("no workflow with label")
+	ErrWorkflowCycle   = errors.New("workflows form a cycle")
+)
+
+// A rule of a workflow, like a<1006:qkq, sending a part to the target if its property passes the comparison.
+// The last rule of a workflow has no condition, so only the target is set
+type WorkflowRule struct {
+	// One of x, m, a or s
+	Property byte
+	// One of < or >, or zero if there is no condition
+	Comparison byte
+	Value      int
+	Target     string
+}
+
+func (rule WorkflowRule) HasCondition() bool {
+	return rule.Comparison != 0
+}
+
+// A workflow line, like the example: px{a<2006:qkq,m>2090:A,rfg}
+type WorkflowLine struct {
+	Name  string
+	Rules []WorkflowRule
+}
+
+// Given a field defining a workflow rule (something like a<1006:qkq) return the rule
+func parseFieldToWorkflowRule(field parse.Field, lineNumber int) (WorkflowRule, error) {
+	colonIndex := strings.IndexRune(field.Text, ':')
+
+	// If there is no condition, we have a "true" match and the field is the target
+	if colonIndex == -1 {
+		if len(field.Text) == 0 {
+			return WorkflowRule{}, parse.Errorf(DAY, lineNumber, field.Column, field.Text, "expected a workflow target")
+		}
+		return WorkflowRule{Target: field.Text}, nil
+	}
+
+	// A condition needs at least a property, a comparison and one digit
+	if colonIndex < 3 {
+		return WorkflowRule{}, parse.Errorf(DAY, lineNumber, field.Column, field.Text, "expected a condition like \"a<1006\" before \":\"")
+	}
+
+	// The target of the workflow if resolves to true
+	workflowTarget := field.Text[colonIndex+1:]
+	if len(workflowTarget) == 0 {
+		return WorkflowRule{}, parse.Errorf(DAY, lineNumber, field.Column+colonIndex+1, field.Text, "expected a workflow target after \":\"")
+	}
+
+	// The part property of interest
+	workflowProperty := field.Text[0]
+	if !strings.ContainsRune("xmas", rune(workflowProperty)) {
+		return WorkflowRule{}, parse.Errorf(DAY, lineNumber, field.Column, field.Text, "unknown part property %q", field.Text[:1])
+	}
+
+	// The comparison operator to use in the workflow
+	workflowComparison := field.Text[1]
+	if workflowComparison != '<' && workflowComparison != '>' {
+		return WorkflowRule{}, parse.Errorf(DAY, lineNumber, field.Column+1, field.Text, "unknown comparison %q", field.Text[1:2])
+	}
+
+	// The value to compare to in the workflow
+	workflowComparisonValue, err := parse.Atoi(DAY, lineNumber, parse.Field{Text: field.Text[2:colonIndex], Column: field.Column + 2})
+	if err != nil {
+		return WorkflowRule{}, err
+	}
+
+	return WorkflowRule{
+		Property:   workflowProperty,
+		Comparison: workflowComparison,
+		Value:      workflowComparisonValue,
+		Target:     workflowTarget,
+	}, nil
+}
+
+// Parse a line like px{a<2006:qkq,m>2090:A,rfg} to its name and rules, which both parts
+// turn into workflows of their own
+//
+// The last rule of a workflow must have no condition, so every part has a target
+func ParseWorkflowLine(line string, lineNumber int) (WorkflowLine, error) {
+	nameEndIndex := strings.IndexRune(line, '{')
+	if nameEndIndex < 1 {
+		return WorkflowLine{}, parse.Errorf(DAY, lineNumber, 1, line, "expected a workflow name followed by \"{\"")
+	}
+	if !strings.HasSuffix(line, "}") {
+		return WorkflowLine{}, parse.Errorf(DAY, lineNumber, len(line), line, "expected workflow to end with \"}\"")
+	}
+	workflowName := line[:nameEndIndex]
+
+	workflowRulesSection := line[nameEndIndex+1 : len(line)-1]
+	workflowRuleFields := parse.Split(workflowRulesSection, ",", nameEndIndex+2)
+
+	workflowRules := make([]WorkflowRule, len(workflowRuleFields))
+	var err error
+	for index, workflowRuleField := range workflowRuleFields {
+		workflowRules[index], err = parseFieldToWorkflowRule(workflowRuleField, lineNumber)
+		if err != nil {
+			return WorkflowLine{}, err
+		}
+	}
+	lastField := workflowRuleFields[len(workflowRuleFields)-1]
+	if workflowRules[len(workflowRules)-1].HasCondition() {
+		return WorkflowLine{}, parse.Errorf(DAY, lineNumber, lastField.Column, lastField.Text, "expected the last function of a workflow to have no condition")
+	}
+
+	log.Debug().
+		Str("WorkflowName", workflowName).
+		Interface("WorkflowRules", workflowRules).
+		Msg("ParsedWorkflow")
+
+	return WorkflowLine{
+		Name:  workflowName,
+		Rules: workflowRules,
+	}, nil
+}
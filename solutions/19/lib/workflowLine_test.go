@@ -0,0 +1,55 @@
+package lib
+
+import (
+	"fmt"
+	"hmcalister/aocCommon/golden"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// Print a workflow line back in the form of the input, such as px{a<2006:qkq,m>2090:A,rfg}
+func formatWorkflowLine(workflowLine WorkflowLine) string {
+	fields := make([]string, len(workflowLine.Rules))
+	for index, rule := range workflowLine.Rules {
+		if rule.HasCondition() {
+			fields[index] = fmt.Sprintf("%c%c%v:%v", rule.Property, rule.Comparison, rule.Value, rule.Target)
+		} else {
+			fields[index] = rule.Target
+		}
+	}
+	return fmt.Sprintf("%v{%v}", workflowLine.Name, strings.Join(fields, ","))
+}
+
+// Seeded with every line of the examples of both parts, including the part lines
+func FuzzParseWorkflowLine(f *testing.F) {
+	for _, dir := range []string{"../part01/" + golden.TESTDATA_DIR, "../part02/" + golden.TESTDATA_DIR} {
+		lines, err := golden.ExampleLines(dir)
+		if err != nil {
+			f.Fatal(err)
+		}
+		for _, line := range lines {
+			f.Add(line)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, line string) {
+		workflowLine, err := ParseWorkflowLine(line, 1)
+		if err != nil {
+			golden.CheckParseError(t, line, err)
+			return
+		}
+		if len(workflowLine.Rules) == 0 || workflowLine.Rules[len(workflowLine.Rules)-1].HasCondition() {
+			t.Fatalf("parsed %q to a workflow whose last rule can fail", line)
+		}
+
+		printed := formatWorkflowLine(workflowLine)
+		reparsed, err := ParseWorkflowLine(printed, 1)
+		if err != nil {
+			t.Fatalf("parsed %q but failed to parse it printed as %q: %v", line, printed, err)
+		}
+		if !reflect.DeepEqual(reparsed, workflowLine) {
+			t.Errorf("parsed %q and %q to different workflows", line, printed)
+		}
+	})
+}
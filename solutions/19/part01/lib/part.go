@@ -1,10 +1,8 @@
 package lib
 
 import (
-	"strconv"
+	"hmcalister/aocCommon/parse"
 	"strings"
-
-	"github.com/rs/zerolog/log"
 )
 
 const (
@@ -21,21 +19,25 @@ type PartData struct {
 	ShinyRating         int
 }
 
-func ParseLineToPartData(line string) PartData {
-	line = line[1 : len(line)-1]
-	fields := strings.FieldsFunc(line, func(r rune) bool {
-		return r == ','
-	})
+// Parse a line like {x=787,m=2655,a=1222,s=2876} to a part
+func ParseLineToPartData(line string, lineNumber int) (PartData, error) {
+	if !strings.HasPrefix(line, "{") || !strings.HasSuffix(line, "}") || len(line) < 2 {
+		return PartData{}, parse.Errorf(DAY, lineNumber, 1, line, "expected part ratings surrounded by \"{\" and \"}\"")
+	}
+	fields := parse.Split(line[1:len(line)-1], ",", 2)
 
 	newPart := PartData{}
 
 	for _, field := range fields {
-		propertyValue, err := strconv.Atoi(field[2:])
+		if len(field.Text) < 2 || field.Text[1] != '=' {
+			return PartData{}, parse.Errorf(DAY, lineNumber, field.Column, field.Text, "expected a rating like \"x=787\"")
+		}
+		propertyValue, err := parse.Atoi(DAY, lineNumber, parse.Field{Text: field.Text[2:], Column: field.Column + 2})
 		if err != nil {
-			log.Fatal().Msgf("failed to parse part property value %v in line %v", field[2:], line)
+			return PartData{}, err
 		}
 
-		switch field[:1] {
+		switch field.Text[:1] {
 		case ExtremelyCoolString:
 			newPart.ExtremelyCoolRating = propertyValue
 		case MusicalString:
@@ -44,10 +46,12 @@ func ParseLineToPartData(line string) PartData {
 			newPart.AerodynamicRating = propertyValue
 		case ShinyString:
 			newPart.ShinyRating = propertyValue
+		default:
+			return PartData{}, parse.Errorf(DAY, lineNumber, field.Column, field.Text, "unknown part property %q", field.Text[:1])
 		}
 	}
 
-	return newPart
+	return newPart, nil
 }
 
 func (part *PartData) SumRatings() int {
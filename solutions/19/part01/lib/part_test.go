@@ -0,0 +1,38 @@
+package lib
+
+import (
+	"fmt"
+	"hmcalister/aocCommon/golden"
+	"strings"
+	"testing"
+)
+
+// Seeded with the part lines of the example, skipping the workflows
+func FuzzParseLineToPartData(f *testing.F) {
+	lines, err := golden.ExampleLines("../" + golden.TESTDATA_DIR)
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, line := range lines {
+		if strings.HasPrefix(line, "{") {
+			f.Add(line)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, line string) {
+		part, err := ParseLineToPartData(line, 1)
+		if err != nil {
+			golden.CheckParseError(t, line, err)
+			return
+		}
+
+		printed := fmt.Sprintf("{x=%v,m=%v,a=%v,s=%v}", part.ExtremelyCoolRating, part.MusicalRating, part.AerodynamicRating, part.ShinyRating)
+		reparsed, err := ParseLineToPartData(printed, 1)
+		if err != nil {
+			t.Fatalf("parsed %q but failed to parse it printed as %q: %v", line, printed, err)
+		}
+		if reparsed != part {
+			t.Errorf("parsed %q to %v but %q to %v", line, part, printed, reparsed)
+		}
+	})
+}
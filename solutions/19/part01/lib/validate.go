@@ -0,0 +1,65 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/input"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+)
+
+// Check the input is a block of workflows followed by a block of parts, with an entry point workflow "in"
+// and every target workflow defined. Part 2 ignores the parts, but they are still checked
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+	blocks := input.Blocks(input.FromScanner(fileScanner))
+
+	if !blocks.Next() {
+		if err := blocks.Err(); err != nil {
+			report.Add(err)
+		}
+		report.Addf(0, 0, "", "expected a block of workflows")
+		return report.Violations
+	}
+	workflowBlock := blocks.Value()
+	// Parsed workflows by line index, nil for those that failed to parse
+	workflows := make([]*Workflow, len(workflowBlock.Lines))
+	definedWorkflows := map[string]interface{}{ACCEPT_PART: nil, REJECT_PART: nil}
+	for lineIndex, line := range workflowBlock.Lines {
+		workflow, err := ParseLineToWorkflow(line, workflowBlock.FirstLineNumber+lineIndex)
+		if err != nil {
+			report.Add(err)
+			continue
+		}
+		workflows[lineIndex] = &workflow
+		definedWorkflows[workflow.WorkflowName] = nil
+	}
+	if _, ok := definedWorkflows["in"]; !ok {
+		report.Addf(0, 0, "", "no entry point workflow with label \"in\"")
+	}
+	for lineIndex, workflow := range workflows {
+		if workflow == nil {
+			continue
+		}
+		for _, target := range workflow.WorkflowTargets {
+			if _, ok := definedWorkflows[target]; !ok {
+				report.Addf(workflowBlock.FirstLineNumber+lineIndex, 0, workflowBlock.Lines[lineIndex], "workflow %q is never defined", target)
+			}
+		}
+	}
+
+	if !blocks.Next() {
+		if err := blocks.Err(); err != nil {
+			report.Add(err)
+		}
+		report.Addf(0, 0, "", "expected a block of parts after the workflows")
+		return report.Violations
+	}
+	partBlock := blocks.Value()
+	for lineIndex, line := range partBlock.Lines {
+		if _, err := ParseLineToPartData(line, partBlock.FirstLineNumber+lineIndex); err != nil {
+			report.Add(err)
+		}
+	}
+
+	return report.Violations
+}
@@ -1,12 +1,12 @@
 package lib
 
 import (
-	"strconv"
-	"strings"
-
-	"github.com/rs/zerolog/log"
+	"fmt"
+	day19lib "hmcalister/aoc19/lib"
 )
 
+const DAY = 19
+
 const (
 	REJECT_PART string = "R"
 	ACCEPT_PART string = "A"
@@ -30,104 +30,62 @@ type Workflow struct {
 
 type workflowFunction func(PartData) bool
 
-func constructWorkflowFunction(workflowProperty string, comparisonFunc comparisonFunctionType, comparisonValue int) workflowFunction {
+func constructWorkflowFunction(getPartProperty func(PartData) int, comparisonFunc comparisonFunctionType, comparisonValue int) workflowFunction {
 	return func(part PartData) bool {
-		workflowProperty := workflowProperty
-
-		var partProperty int
-		switch workflowProperty {
-		case ExtremelyCoolString:
-			partProperty = part.ExtremelyCoolRating
-		case MusicalString:
-			partProperty = part.MusicalRating
-		case AerodynamicString:
-			partProperty = part.AerodynamicRating
-		case ShinyString:
-			partProperty = part.ShinyRating
-		default:
-			log.Fatal().Msgf("failed to parse workflow property %v", workflowProperty)
-		}
-		return comparisonFunc(partProperty, comparisonValue)
+		return comparisonFunc(getPartProperty(part), comparisonValue)
 	}
 }
 
-// Given a string defining a workflow function and target (something like a<1006:qkq)
-// return a workflow function implementing this logic and the target of the function if true
-func parseFieldToWorkflowFunction(field string) (workflowFunction, string) {
-	colonIndex := strings.IndexRune(field, ':')
-
-	// If there is no condition, we have a "true" match and the field is the target
-	if colonIndex == -1 {
-		log.Debug().
-			Str("WorkflowTarget", field).
-			Msg("ParsedWorkflow")
-
-		return func(pd PartData) bool { return true }, field
+// Given a rule of a workflow (something like a<1006:qkq) return a workflow function implementing this logic
+func ruleToWorkflowFunction(rule day19lib.WorkflowRule) workflowFunction {
+	// If there is no condition, we have a "true" match
+	if !rule.HasCondition() {
+		return func(pd PartData) bool { return true }
 	}
 
-	// The target of the workflow if resolves to true
-	workflowTarget := field[colonIndex+1:]
-
 	// The part property of interest
-	workflowProperty := field[:1]
-
-	// The comparison operator to use in the workflow
-	workflowComparison := field[1:2]
-
-	// The value to compare to in the workflow
-	workflowComparisonValueString := field[2:colonIndex]
-	workflowComparisonValue, err := strconv.Atoi(workflowComparisonValueString)
-	if err != nil {
-		log.Fatal().Msgf("failed to parse workflow comparison value %v to int in workflow string %v", workflowComparisonValueString, field)
+	var getPartProperty func(PartData) int
+	switch string(rule.Property) {
+	case ExtremelyCoolString:
+		getPartProperty = func(part PartData) int { return part.ExtremelyCoolRating }
+	case MusicalString:
+		getPartProperty = func(part PartData) int { return part.MusicalRating }
+	case AerodynamicString:
+		getPartProperty = func(part PartData) int { return part.AerodynamicRating }
+	case ShinyString:
+		getPartProperty = func(part PartData) int { return part.ShinyRating }
 	}
 
-	var comparisonFunc comparisonFunctionType
-	switch workflowComparison {
-	case "<":
-		comparisonFunc = lessThanFunc
-	case ">":
+	// The comparison operator to use in the workflow
+	comparisonFunc := lessThanFunc
+	if rule.Comparison == '>' {
 		comparisonFunc = greaterThanFunc
-	default:
-		log.Fatal().Msgf("failed to parse comparison rune %v in workflow string %v", workflowComparison, field)
 	}
 
-	log.Debug().
-		Str("workflowProperty", workflowProperty).
-		Str("WorkflowComparison", workflowComparison).
-		Int("WorkflowComparisonValue", workflowComparisonValue).
-		Str("WorkflowTarget", workflowTarget).
-		Msg("ParsedWorkflow")
-
-	return constructWorkflowFunction(workflowProperty, comparisonFunc, workflowComparisonValue), workflowTarget
+	return constructWorkflowFunction(getPartProperty, comparisonFunc, rule.Value)
 }
 
-func ParseLineToWorkflow(line string) Workflow {
-	nameEndIndex := strings.IndexRune(line, '{')
-	workflowName := line[:nameEndIndex]
-
-	workflowFunctionsSection := line[nameEndIndex+1 : len(line)-1]
-	workflowFunctionsStrings := strings.FieldsFunc(workflowFunctionsSection, func(r rune) bool {
-		return r == ','
-	})
-
-	workflowFuncs := make([]workflowFunction, len(workflowFunctionsStrings))
-	workflowTargets := make([]string, len(workflowFunctionsStrings))
-
-	for index, workflowFunctionString := range workflowFunctionsStrings {
-		workflowFuncs[index], workflowTargets[index] = parseFieldToWorkflowFunction(workflowFunctionString)
+// Parse a line like px{a<2006:qkq,m>2090:A,rfg} to a workflow
+//
+// The last function of a workflow must have no condition, so every part has a target
+func ParseLineToWorkflow(line string, lineNumber int) (Workflow, error) {
+	workflowLine, err := day19lib.ParseWorkflowLine(line, lineNumber)
+	if err != nil {
+		return Workflow{}, err
 	}
 
-	log.Debug().
-		Str("WorkflowName", workflowName).
-		Int("NumberOfWorkflowFunctions", len(workflowFuncs)).
-		Interface("WorkflowTargets", workflowTargets).
-		Send()
+	workflowFuncs := make([]workflowFunction, len(workflowLine.Rules))
+	workflowTargets := make([]string, len(workflowLine.Rules))
+	for index, rule := range workflowLine.Rules {
+		workflowFuncs[index] = ruleToWorkflowFunction(rule)
+		workflowTargets[index] = rule.Target
+	}
 
 	return Workflow{
-		WorkflowName:    workflowName,
+		WorkflowName:    workflowLine.Name,
 		WorkflowFuncs:   workflowFuncs,
 		WorkflowTargets: workflowTargets,
-	}
+	}, nil
 }
 
 // Pass a part through the workflow functions, returning the target string of the first match
@@ -144,24 +102,27 @@ func (flow Workflow) passPartThroughWorkflowFunctions(part PartData) string {
 
 // Given a part and a collection of workflows organized into a map (with start point having label "in"),
 // process the part through each workflow until the target label is either ACCEPT (true) or REJECT (false)
-func ProcessPart(part PartData, workflowMap map[string]Workflow) bool {
+//
+// A part visiting more workflows than there are must be going around a cycle, so is an error
+func ProcessPart(part PartData, workflowMap map[string]Workflow) (bool, error) {
 	currentWorkflow, ok := workflowMap["in"]
 	if !ok {
-		log.Fatal().Msg("no entry point workflow with label \"in\"")
+		return false, day19lib.ErrNoEntryWorkflow
 	}
 
-	for {
+	for workflowsVisited := 1; workflowsVisited <= len(workflowMap); workflowsVisited += 1 {
 		nextWorkflowName := currentWorkflow.passPartThroughWorkflowFunctions(part)
 		if nextWorkflowName == ACCEPT_PART {
-			return true
+			return true, nil
 		}
 		if nextWorkflowName == REJECT_PART {
-			return false
+			return false, nil
 		}
 
 		currentWorkflow, ok = workflowMap[nextWorkflowName]
 		if !ok {
-			log.Fatal().Msgf("no next workflow with label \"%v\"", nextWorkflowName)
+			return false, fmt.Errorf("%w %q", day19lib.ErrUnknownWorkflow, nextWorkflowName)
 		}
 	}
+	return false, day19lib.ErrWorkflowCycle
 }
@@ -2,43 +2,64 @@ package part01
 
 import (
 	"bufio"
+	"errors"
 	"hmcalister/aoc19/part01/lib"
+	"hmcalister/aocCommon/input"
 
 	"github.com/rs/zerolog/log"
 )
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	var line string
+	blocks := input.Blocks(input.FromScanner(fileScanner))
+	if !blocks.Next() {
+		if err := blocks.Err(); err != nil {
+			return 0, err
+		}
+		return 0, errors.New("expected a block of workflows")
+	}
 
 	workflowMap := make(map[string]lib.Workflow)
 
 	// Parse the workflows
-	for fileScanner.Scan() {
-		line = fileScanner.Text()
-		if len(line) == 0 {
-			break
-		}
+	workflowBlock := blocks.Value()
+	for lineIndex, line := range workflowBlock.Lines {
 		log.Debug().
 			Str("RawLine", line).
 			Send()
 
-		newWorkflow := lib.ParseLineToWorkflow(line)
+		newWorkflow, err := lib.ParseLineToWorkflow(line, workflowBlock.FirstLineNumber+lineIndex)
+		if err != nil {
+			return 0, err
+		}
 		workflowMap[newWorkflow.WorkflowName] = newWorkflow
+	}
 
+	if !blocks.Next() {
+		if err := blocks.Err(); err != nil {
+			return 0, err
+		}
+		return 0, errors.New("expected a block of parts after the workflows")
 	}
 
 	totalAcceptedRatings := 0
 	// Parse the parts
-	for fileScanner.Scan() {
-		line = fileScanner.Text()
-		part := lib.ParseLineToPartData(line)
+	partBlock := blocks.Value()
+	for lineIndex, line := range partBlock.Lines {
+		part, err := lib.ParseLineToPartData(line, partBlock.FirstLineNumber+lineIndex)
+		if err != nil {
+			return 0, err
+		}
 
 		log.Debug().
 			Str("RawLine", line).
 			Interface("ParsedPart", part).
 			Send()
 
-		if lib.ProcessPart(part, workflowMap) {
+		accepted, err := lib.ProcessPart(part, workflowMap)
+		if err != nil {
+			return 0, err
+		}
+		if accepted {
 			totalAcceptedRatings += part.SumRatings()
 		}
 	}
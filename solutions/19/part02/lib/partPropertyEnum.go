@@ -9,3 +9,11 @@ const (
 	AerodynamicProperty   partPropertyEnum = 'a'
 	ShinyProperty         partPropertyEnum = 's'
 )
+
+// The axis of each property in the ratings of a PartPropertySpaceRange
+var propertyAxes = map[partPropertyEnum]int{
+	ExtremelyCoolProperty: 0,
+	MusicalProperty:       1,
+	AerodynamicProperty:   2,
+	ShinyProperty:         3,
+}
@@ -1,53 +1,30 @@
 package lib
 
-import "fmt"
-
-type PropertySpaceRange struct {
-	// inclusive start of the range
-	RangeStart int
-
-	// exclusive end of the range
-	RangeEnd int
-}
-
-func (spaceRange PropertySpaceRange) size() int {
-	return spaceRange.RangeEnd - spaceRange.RangeStart
-}
-
-func (spaceRange PropertySpaceRange) string() string {
-	return fmt.Sprintf("(%v, %v)", spaceRange.RangeStart, spaceRange.RangeEnd)
-}
+import (
+	"fmt"
+	"hmcalister/aocCommon/interval"
+)
 
 type PartPropertySpaceRange struct {
-	NextWorkflow        string
-	ExtremelyCoolRating PropertySpaceRange
-	MusicalRating       PropertySpaceRange
-	AerodynamicRating   PropertySpaceRange
-	ShinyRating         PropertySpaceRange
+	NextWorkflow string
+	// The ratings of the parts in the range, with an axis for each property in the order of propertyAxes
+	Ratings interval.Box
+	// The number of workflows the range has passed through, which is more than the number of workflows only on a cycle
+	WorkflowsVisited int
 }
 
 func InitialPartPropertySpaceRange() PartPropertySpaceRange {
-	rangeStart := 1
-	rangeEnd := 4001
+	ratingRange := interval.New(1, 4001)
 	return PartPropertySpaceRange{
-		NextWorkflow:        "in",
-		ExtremelyCoolRating: PropertySpaceRange{rangeStart, rangeEnd},
-		MusicalRating:       PropertySpaceRange{rangeStart, rangeEnd},
-		AerodynamicRating:   PropertySpaceRange{rangeStart, rangeEnd},
-		ShinyRating:         PropertySpaceRange{rangeStart, rangeEnd},
+		NextWorkflow: "in",
+		Ratings:      interval.NewBox(ratingRange, ratingRange, ratingRange, ratingRange),
 	}
 }
 
 func (partSpaceRange PartPropertySpaceRange) String() string {
-	return fmt.Sprintf("%v, %v, %v, %v, %v",
-		partSpaceRange.ExtremelyCoolRating.string(),
-		partSpaceRange.MusicalRating.string(),
-		partSpaceRange.AerodynamicRating.string(),
-		partSpaceRange.ShinyRating.string(),
-		partSpaceRange.NextWorkflow,
-	)
+	return fmt.Sprintf("%v, %v", partSpaceRange.Ratings, partSpaceRange.NextWorkflow)
 }
 
 func (partSpaceRange PartPropertySpaceRange) Size() int {
-	return partSpaceRange.ExtremelyCoolRating.size() * partSpaceRange.MusicalRating.size() * partSpaceRange.AerodynamicRating.size() * partSpaceRange.ShinyRating.size()
+	return partSpaceRange.Ratings.Volume()
 }
@@ -1,7 +1,7 @@
 package lib
 
 import (
-	"strings"
+	day19lib "hmcalister/aoc19/lib"
 
 	"github.com/rs/zerolog/log"
 )
@@ -27,33 +27,27 @@ type Workflow struct {
 	WorkflowTargets []string
 }
 
-func ParseLineToWorkflow(line string) Workflow {
-	nameEndIndex := strings.IndexRune(line, '{')
-	workflowName := line[:nameEndIndex]
-
-	workflowFunctionsSection := line[nameEndIndex+1 : len(line)-1]
-	workflowFunctionsStrings := strings.FieldsFunc(workflowFunctionsSection, func(r rune) bool {
-		return r == ','
-	})
-
-	workflowFuncs := make([]workflowFunction, len(workflowFunctionsStrings))
-	workflowTargets := make([]string, len(workflowFunctionsStrings))
-
-	for index, workflowFunctionString := range workflowFunctionsStrings {
-		workflowFuncs[index], workflowTargets[index] = parseFieldToWorkflowFunction(workflowFunctionString)
+// Parse a line like px{a<2006:qkq,m>2090:A,rfg} to a workflow
+//
+// The last function of a workflow must have no condition, so every part has a target
+func ParseLineToWorkflow(line string, lineNumber int) (Workflow, error) {
+	workflowLine, err := day19lib.ParseWorkflowLine(line, lineNumber)
+	if err != nil {
+		return Workflow{}, err
 	}
 
-	log.Debug().
-		Str("WorkflowName", workflowName).
-		Int("NumberOfWorkflowFunctions", len(workflowFuncs)).
-		Interface("WorkflowTargets", workflowTargets).
-		Send()
+	workflowFuncs := make([]workflowFunction, len(workflowLine.Rules))
+	workflowTargets := make([]string, len(workflowLine.Rules))
+	for index, rule := range workflowLine.Rules {
+		workflowFuncs[index] = ruleToWorkflowFunction(rule)
+		workflowTargets[index] = rule.Target
+	}
 
 	return Workflow{
-		WorkflowName:    workflowName,
+		WorkflowName:    workflowLine.Name,
 		WorkflowFuncs:   workflowFuncs,
 		WorkflowTargets: workflowTargets,
-	}
+	}, nil
 }
 
 func (flow Workflow) FindNextPartSpaceRanges(currentPartSpaceRange PartPropertySpaceRange) []PartPropertySpaceRange {
@@ -67,36 +61,13 @@ func (flow Workflow) FindNextPartSpaceRanges(currentPartSpaceRange PartPropertyS
 			Int("FunctionIndex", functionIndex).
 			Str("CurrentModifiedPartSpaceRange", currentModifiedPartSpaceRange.String()).
 			Send()
-		nextPartSpaceRange := currentModifiedPartSpaceRange
-
-		nextPartSpaceRange.NextWorkflow = flow.WorkflowTargets[functionIndex]
-
-		switch function.targetPartProperty {
-		case ExtremelyCoolProperty:
-			nextPartSpaceRange.ExtremelyCoolRating.RangeStart = function.passingRangeUpdateFunc(nextPartSpaceRange.ExtremelyCoolRating.RangeStart, function.comparisonValue)
-			nextPartSpaceRange.ExtremelyCoolRating.RangeEnd = function.passingRangeUpdateFunc(nextPartSpaceRange.ExtremelyCoolRating.RangeEnd, function.comparisonValue)
-
-			currentModifiedPartSpaceRange.ExtremelyCoolRating.RangeStart = function.failingRangeUpdateFunc(currentModifiedPartSpaceRange.ExtremelyCoolRating.RangeStart, function.comparisonValue)
-			currentModifiedPartSpaceRange.ExtremelyCoolRating.RangeEnd = function.failingRangeUpdateFunc(currentModifiedPartSpaceRange.ExtremelyCoolRating.RangeEnd, function.comparisonValue)
-		case MusicalProperty:
-			nextPartSpaceRange.MusicalRating.RangeStart = function.passingRangeUpdateFunc(nextPartSpaceRange.MusicalRating.RangeStart, function.comparisonValue)
-			nextPartSpaceRange.MusicalRating.RangeEnd = function.passingRangeUpdateFunc(nextPartSpaceRange.MusicalRating.RangeEnd, function.comparisonValue)
-
-			currentModifiedPartSpaceRange.MusicalRating.RangeStart = function.failingRangeUpdateFunc(currentModifiedPartSpaceRange.MusicalRating.RangeStart, function.comparisonValue)
-			currentModifiedPartSpaceRange.MusicalRating.RangeEnd = function.failingRangeUpdateFunc(currentModifiedPartSpaceRange.MusicalRating.RangeEnd, function.comparisonValue)
-		case AerodynamicProperty:
-			nextPartSpaceRange.AerodynamicRating.RangeStart = function.passingRangeUpdateFunc(nextPartSpaceRange.AerodynamicRating.RangeStart, function.comparisonValue)
-			nextPartSpaceRange.AerodynamicRating.RangeEnd = function.passingRangeUpdateFunc(nextPartSpaceRange.AerodynamicRating.RangeEnd, function.comparisonValue)
-
-			currentModifiedPartSpaceRange.AerodynamicRating.RangeStart = function.failingRangeUpdateFunc(currentModifiedPartSpaceRange.AerodynamicRating.RangeStart, function.comparisonValue)
-			currentModifiedPartSpaceRange.AerodynamicRating.RangeEnd = function.failingRangeUpdateFunc(currentModifiedPartSpaceRange.AerodynamicRating.RangeEnd, function.comparisonValue)
-		case ShinyProperty:
-			nextPartSpaceRange.ShinyRating.RangeStart = function.passingRangeUpdateFunc(nextPartSpaceRange.ShinyRating.RangeStart, function.comparisonValue)
-			nextPartSpaceRange.ShinyRating.RangeEnd = function.passingRangeUpdateFunc(nextPartSpaceRange.ShinyRating.RangeEnd, function.comparisonValue)
-
-			currentModifiedPartSpaceRange.ShinyRating.RangeStart = function.failingRangeUpdateFunc(currentModifiedPartSpaceRange.ShinyRating.RangeStart, function.comparisonValue)
-			currentModifiedPartSpaceRange.ShinyRating.RangeEnd = function.failingRangeUpdateFunc(currentModifiedPartSpaceRange.ShinyRating.RangeEnd, function.comparisonValue)
+		passingRatings, failingRatings := function.splitRatings(currentModifiedPartSpaceRange.Ratings)
+		nextPartSpaceRange := PartPropertySpaceRange{
+			NextWorkflow:     flow.WorkflowTargets[functionIndex],
+			Ratings:          passingRatings,
+			WorkflowsVisited: currentPartSpaceRange.WorkflowsVisited + 1,
 		}
+		currentModifiedPartSpaceRange.Ratings = failingRatings
 
 		log.Trace().
 			Str("ResultingRange", nextPartSpaceRange.String()).
@@ -1,71 +1,43 @@
 package lib
 
 import (
-	"strconv"
-	"strings"
-
-	"github.com/rs/zerolog/log"
+	day19lib "hmcalister/aoc19/lib"
+	"hmcalister/aocCommon/interval"
 )
 
 type workflowFunction struct {
-	targetPartProperty     partPropertyEnum
-	comparisonType         comparisonTypeEnum
-	passingRangeUpdateFunc comparisonFunctionType
-	failingRangeUpdateFunc comparisonFunctionType
-	comparisonValue        int
+	targetPartProperty partPropertyEnum
+	comparisonType     comparisonTypeEnum
+	comparisonValue    int
 }
 
-// Given a string defining a workflow function and target (something like a<1006:qkq)
-// return a workflow function implementing this logic and the target of the function if true
-func parseFieldToWorkflowFunction(field string) (workflowFunction, string) {
-	colonIndex := strings.IndexRune(field, ':')
-
-	// If there is no condition, we have a "true" match and the field is the target
-	if colonIndex == -1 {
-		log.Debug().
-			Str("WorkflowTarget", field).
-			Msg("ParsedWorkflow")
-
-		passingRangeUpdateFunc, failingRangeUpdateFunc := getUpdateFuncs(GREATER_THAN)
-		return workflowFunction{
-			targetPartProperty:     ExtremelyCoolProperty,
-			comparisonType:         GREATER_THAN,
-			passingRangeUpdateFunc: passingRangeUpdateFunc,
-			failingRangeUpdateFunc: failingRangeUpdateFunc,
-			comparisonValue:        0,
-		}, field
+// Split the ratings of a range of parts into those passing this function and those failing it
+//
+// If a function requires, for example x LESS_THAN 2000, then the x axis is split at 2000 with
+// the ratings below passing. For x GREATER_THAN 2000 it is split at 2001 with the ratings above passing.
+func (function workflowFunction) splitRatings(ratings interval.Box) (interval.Box, interval.Box) {
+	axis := propertyAxes[function.targetPartProperty]
+	if function.comparisonType == LESS_THAN {
+		return ratings.SplitAt(axis, function.comparisonValue)
 	}
+	below, above := ratings.SplitAt(axis, function.comparisonValue+1)
+	return above, below
+}
 
-	// The target of the workflow if resolves to true
-	workflowTarget := field[colonIndex+1:]
-
-	// The part property of interest
-	workflowProperty := partPropertyEnum(field[:1][0])
-
-	// The comparison operator to use in the workflow
-	workflowComparison := comparisonTypeEnum(field[1:2][0])
-
-	// The value to compare to in the workflow
-	workflowComparisonValueString := field[2:colonIndex]
-	workflowComparisonValue, err := strconv.Atoi(workflowComparisonValueString)
-	if err != nil {
-		log.Fatal().Msgf("failed to parse workflow comparison value %v to int in workflow string %v", workflowComparisonValueString, field)
+// Given a rule of a workflow (something like a<1006:qkq) return a workflow function implementing this logic
+func ruleToWorkflowFunction(rule day19lib.WorkflowRule) workflowFunction {
+	// Every rating is at least 1, so x > 0 always passes
+	if !rule.HasCondition() {
+		return workflowFunction{
+			targetPartProperty: ExtremelyCoolProperty,
+			comparisonType:     GREATER_THAN,
+			comparisonValue:    0,
+		}
 	}
 
-	passingRangeUpdateFunc, failingRangeUpdateFunc := getUpdateFuncs(workflowComparison)
-
-	log.Debug().
-		Str("workflowProperty", workflowProperty.String()).
-		Str("WorkflowComparison", workflowComparison.String()).
-		Int("WorkflowComparisonValue", workflowComparisonValue).
-		Str("WorkflowTarget", workflowTarget).
-		Msg("ParsedWorkflow")
-
 	return workflowFunction{
-		targetPartProperty:     workflowProperty,
-		comparisonType:         workflowComparison,
-		passingRangeUpdateFunc: passingRangeUpdateFunc,
-		failingRangeUpdateFunc: failingRangeUpdateFunc,
-		comparisonValue:        workflowComparisonValue,
-	}, workflowTarget
+		targetPartProperty: partPropertyEnum(rule.Property),
+		comparisonType:     comparisonTypeEnum(rule.Comparison),
+		comparisonValue:    rule.Value,
+	}
 }
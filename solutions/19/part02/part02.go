@@ -2,31 +2,45 @@ package part02
 
 import (
 	"bufio"
+	"errors"
+	"fmt"
+	day19lib "hmcalister/aoc19/lib"
 	"hmcalister/aoc19/part02/lib"
+	"hmcalister/aocCommon/input"
 
 	"github.com/rs/zerolog/log"
 )
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	var line string
+	// Only the workflows are needed, the parts that follow are ignored
+	blocks := input.Blocks(input.FromScanner(fileScanner))
+	if !blocks.Next() {
+		if err := blocks.Err(); err != nil {
+			return 0, err
+		}
+		return 0, errors.New("expected a block of workflows")
+	}
 
 	workflowMap := make(map[string]lib.Workflow)
 
 	// Parse the workflows
-	for fileScanner.Scan() {
-		line = fileScanner.Text()
-		if len(line) == 0 {
-			break
-		}
+	workflowBlock := blocks.Value()
+	for lineIndex, line := range workflowBlock.Lines {
 		log.Debug().
 			Str("RawLine", line).
 			Send()
 
-		newWorkflow := lib.ParseLineToWorkflow(line)
+		newWorkflow, err := lib.ParseLineToWorkflow(line, workflowBlock.FirstLineNumber+lineIndex)
+		if err != nil {
+			return 0, err
+		}
 		workflowMap[newWorkflow.WorkflowName] = newWorkflow
 	}
 
 	log.Info().Msg("Finished Parsing Workflows")
+	if _, ok := workflowMap["in"]; !ok {
+		return 0, day19lib.ErrNoEntryWorkflow
+	}
 
 	allPartSpaceRanges := make([]lib.PartPropertySpaceRange, 0)
 	totalAcceptedSpace := 0
@@ -43,9 +57,13 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 			Int("RemainingPartSpaceRanges", len(allPartSpaceRanges)).
 			Send()
 
+		// A part visiting more workflows than there are must be going around a cycle
+		if currentPartSpaceRange.WorkflowsVisited >= len(workflowMap) {
+			return 0, day19lib.ErrWorkflowCycle
+		}
 		currentWorkflow, ok = workflowMap[currentPartSpaceRange.NextWorkflow]
 		if !ok {
-			log.Fatal().Msgf("failed to find workflow with name %v for PartSpaceRange %v", currentPartSpaceRange.NextWorkflow, currentPartSpaceRange)
+			return 0, fmt.Errorf("%w %q", day19lib.ErrUnknownWorkflow, currentPartSpaceRange.NextWorkflow)
 		}
 
 		nextPartSpaceRanges := currentWorkflow.FindNextPartSpaceRanges(currentPartSpaceRange)
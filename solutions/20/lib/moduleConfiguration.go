@@ -2,6 +2,9 @@ package lib
 
 import (
 	"bufio"
+	"fmt"
+	"hmcalister/aocCommon/aocmath"
+	"slices"
 	"strings"
 
 	"github.com/rs/zerolog/log"
@@ -10,6 +13,51 @@ import (
 type ModuleConfigurationData struct {
 	AllModules  map[string]CommunicationModuleType
 	TotalPulses map[PulseTypeEnum]int
+
+	// If set, called after each pulse is received with a description of the pulse
+	OnStep func(description string)
+}
+
+// Report a pulse to the OnStep hook, if set
+func (moduleConfig *ModuleConfigurationData) step(pulse pulseEvent) {
+	if moduleConfig.OnStep != nil {
+		moduleConfig.OnStep(pulse.String())
+	}
+}
+
+// Format the state of each module, one per line and ordered by ID
+func (moduleConfig *ModuleConfigurationData) FormatModuleStates() string {
+	moduleIDs := make([]string, 0, len(moduleConfig.AllModules))
+	for moduleID := range moduleConfig.AllModules {
+		moduleIDs = append(moduleIDs, moduleID)
+	}
+	slices.Sort(moduleIDs)
+
+	var states strings.Builder
+	for _, moduleID := range moduleIDs {
+		switch module := moduleConfig.AllModules[moduleID].(type) {
+		case *FlipFlopModule:
+			state := "off"
+			if module.State {
+				state = "on"
+			}
+			fmt.Fprintf(&states, "%%%v: %v\n", moduleID, state)
+		case *ConjunctionModule:
+			inputIDs := make([]string, 0, len(module.ReceivedPulseMemory))
+			for inputID := range module.ReceivedPulseMemory {
+				inputIDs = append(inputIDs, inputID)
+			}
+			slices.Sort(inputIDs)
+			fmt.Fprintf(&states, "&%v:", moduleID)
+			for _, inputID := range inputIDs {
+				fmt.Fprintf(&states, " %v=%v", inputID, module.ReceivedPulseMemory[inputID].Name())
+			}
+			states.WriteString("\n")
+		default:
+			fmt.Fprintf(&states, "%v\n", moduleID)
+		}
+	}
+	return states.String()
 }
 
 func ParseFileToModuleConfiguration(fileScanner *bufio.Scanner) *ModuleConfigurationData {
@@ -111,9 +159,11 @@ func (moduleConfig *ModuleConfigurationData) PushButton() {
 		targetModule, ok := moduleConfig.AllModules[nextPulseEvent.ReceiverID]
 		if !ok {
 			log.Trace().Msgf("failed to find any module with name %v, continuing", nextPulseEvent.ReceiverID)
+			moduleConfig.step(nextPulseEvent)
 			continue
 		}
 		moduleResponse := targetModule.ReceivePulse(nextPulseEvent.SenderID, nextPulseEvent.PulseValue)
+		moduleConfig.step(nextPulseEvent)
 		if moduleResponse == NO_PULSE {
 			log.Trace().Msg("no pulse received from event")
 			continue
@@ -135,7 +185,7 @@ func (moduleConfig *ModuleConfigurationData) PushButton() {
 	}
 }
 
-func (moduleConfig *ModuleConfigurationData) FindLowestButtonPushesToAchieve_RX_LOW() int {
+func (moduleConfig *ModuleConfigurationData) FindLowestButtonPushesToAchieve_RX_LOW() (int, error) {
 	// The strategy here is to detect all of the cycles in the module config
 	//
 	// The puzzleInput is set up such that the broadcast module sends a signal to 4 other modules
@@ -205,9 +255,11 @@ rxInputSearchLoop:
 			targetModule, ok := moduleConfig.AllModules[nextPulseEvent.ReceiverID]
 			if !ok {
 				log.Trace().Msgf("failed to find any module with name %v, continuing", nextPulseEvent.ReceiverID)
+				moduleConfig.step(nextPulseEvent)
 				continue
 			}
 			moduleResponse := targetModule.ReceivePulse(nextPulseEvent.SenderID, nextPulseEvent.PulseValue)
+			moduleConfig.step(nextPulseEvent)
 			if moduleResponse == NO_PULSE {
 				log.Trace().Msg("no pulse received from event")
 				continue
@@ -236,5 +288,5 @@ rxInputSearchLoop:
 		cycleLengths = append(cycleLengths, cycleLength)
 	}
 
-	return lcmOfSlice(cycleLengths)
+	return aocmath.LCMOfSlice(cycleLengths)
 }
@@ -1,5 +1,10 @@
 package lib
 
+import (
+	"fmt"
+	"strings"
+)
+
 //go:generate stringer -type PulseTypeEnum
 type PulseTypeEnum int
 
@@ -14,3 +19,13 @@ type pulseEvent struct {
 	SenderID   string
 	ReceiverID string
 }
+
+// The name of the pulse as written in the puzzle, such as "low"
+func (pulse PulseTypeEnum) Name() string {
+	return strings.ToLower(strings.TrimSuffix(pulse.String(), "_PULSE"))
+}
+
+// Format the pulse as written in the puzzle, such as "a -high-> b"
+func (pulse pulseEvent) String() string {
+	return fmt.Sprintf("%v -%v-> %v", pulse.SenderID, pulse.PulseValue.Name(), pulse.ReceiverID)
+}
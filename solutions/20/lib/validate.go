@@ -0,0 +1,33 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+	"regexp"
+	"strings"
+)
+
+const DAY = 20
+
+// A flip-flop, conjunction, or the broadcaster, followed by the modules it sends pulses to
+var moduleLineRegexp = regexp.MustCompile(`^(broadcaster|[%&][a-z]+) -> [a-z]+(, [a-z]+)*$`)
+
+// Check each line is a module and its outputs, like "%a -> inv, con", and that there is exactly one broadcaster
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+	lines := validate.ReadLines(fileScanner)
+
+	numBroadcasters := 0
+	for lineIndex, line := range lines {
+		report.CheckPattern(line, lineIndex+1, moduleLineRegexp, "%a -> inv, con")
+		if strings.HasPrefix(line, "broadcaster ") {
+			numBroadcasters += 1
+		}
+	}
+	if numBroadcasters != 1 {
+		report.Addf(0, 0, "", "found %v broadcasters, expected exactly 1", numBroadcasters)
+	}
+
+	return report.Violations
+}
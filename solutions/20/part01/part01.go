@@ -3,12 +3,28 @@ package part01
 import (
 	"bufio"
 	"hmcalister/aoc20/lib"
+	"hmcalister/aocCommon/stepview"
 
 	"github.com/rs/zerolog/log"
 )
 
+// Optional outputs of ProcessInputWithOptions, beyond the answer
+type Options struct {
+	// If not nil, record the state of the modules after each pulse to it
+	Recorder *stepview.Recorder
+}
+
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
+	return ProcessInputWithOptions(fileScanner, Options{})
+}
+
+func ProcessInputWithOptions(fileScanner *bufio.Scanner, options Options) (int, error) {
 	moduleConfig := lib.ParseFileToModuleConfiguration(fileScanner)
+	if options.Recorder != nil {
+		moduleConfig.OnStep = func(description string) {
+			options.Recorder.Record(description, moduleConfig.FormatModuleStates)
+		}
+	}
 
 	for i := 0; i < 1000; i += 1 {
 		moduleConfig.PushButton()
@@ -3,12 +3,26 @@ package part02
 import (
 	"bufio"
 	"hmcalister/aoc20/lib"
+	"hmcalister/aocCommon/stepview"
 )
 
+// Optional outputs of ProcessInputWithOptions, beyond the answer
+type Options struct {
+	// If not nil, record the state of the modules after each pulse to it
+	Recorder *stepview.Recorder
+}
+
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	moduleConfig := lib.ParseFileToModuleConfiguration(fileScanner)
+	return ProcessInputWithOptions(fileScanner, Options{})
+}
 
-	minButtonPressesFor_RX_LOW := moduleConfig.FindLowestButtonPushesToAchieve_RX_LOW()
+func ProcessInputWithOptions(fileScanner *bufio.Scanner, options Options) (int, error) {
+	moduleConfig := lib.ParseFileToModuleConfiguration(fileScanner)
+	if options.Recorder != nil {
+		moduleConfig.OnStep = func(description string) {
+			options.Recorder.Record(description, moduleConfig.FormatModuleStates)
+		}
+	}
 
-	return minButtonPressesFor_RX_LOW, nil
+	return moduleConfig.FindLowestButtonPushesToAchieve_RX_LOW()
 }
@@ -0,0 +1,15 @@
+package part02
+
+import (
+	"hmcalister/aocCommon/golden"
+	"testing"
+)
+
+func TestProcessInput(t *testing.T) {
+	t.Skip("no examples: the examples of the puzzle have no rx module to send a low pulse to")
+	golden.TestSolver(t, ProcessInput)
+}
+
+func BenchmarkProcessInput(b *testing.B) {
+	golden.BenchmarkSolver(b, ProcessInput)
+}
@@ -2,12 +2,17 @@ module hmcalister/aoc21
 
 go 1.21.0
 
-require github.com/rs/zerolog v1.31.0
+require (
+	github.com/openacid/slimarray v0.1.3
+	github.com/rs/zerolog v1.31.0
+	hmcalister/aocCommon v0.0.0
+)
 
 require (
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.19 // indirect
-	github.com/openacid/slimarray v0.1.3 // indirect
 	golang.org/x/sys v0.12.0 // indirect
 	gonum.org/v1/gonum v0.8.1 // indirect
 )
+
+replace hmcalister/aocCommon => ../../common
@@ -2,6 +2,10 @@ package lib
 
 import (
 	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"hmcalister/aocCommon/grid"
 	"slices"
 
 	"github.com/rs/zerolog/log"
@@ -15,77 +19,78 @@ const (
 	SURFACE_ROCK SurfaceTypeEnum = iota
 )
 
+const (
+	DAY = 21
+
+	START_RUNE = 'S'
+)
+
+// The garden repeats infinitely in every direction, so the surface data is accessed with wrapping
 type GardenData struct {
-	SurfaceData     map[coordinate]SurfaceTypeEnum
-	StartCoordinate coordinate
-	MapWidth        int
-	MapHeight       int
+	SurfaceData     *grid.Grid[SurfaceTypeEnum]
+	StartCoordinate grid.Point
 }
 
-func ParseFileToGardenData(fileScanner bufio.Scanner) GardenData {
-	newGarden := GardenData{
-		SurfaceData: make(map[coordinate]SurfaceTypeEnum),
-	}
+var runeToSurfaceTypeMap = map[rune]SurfaceTypeEnum{
+	'.':        SURFACE_PLOT,
+	START_RUNE: SURFACE_PLOT,
+	'#':        SURFACE_ROCK,
+}
 
-	yPosition := 0
-	for fileScanner.Scan() {
-		line := fileScanner.Text()
-		log.Trace().
-			Str("RawLine", line).
-			Send()
-		for runeIndex, r := range line {
-			currentCoordinate := coordinate{X: runeIndex, Y: yPosition}
-			newGarden.MapWidth = max(newGarden.MapWidth, runeIndex+1)
-			newGarden.MapHeight = max(newGarden.MapHeight, yPosition+1)
-			switch r {
-			case '.':
-				newGarden.SurfaceData[currentCoordinate] = SURFACE_PLOT
-			case '#':
-				newGarden.SurfaceData[currentCoordinate] = SURFACE_ROCK
-			case 'S':
-				newGarden.StartCoordinate = currentCoordinate
-				newGarden.SurfaceData[currentCoordinate] = SURFACE_PLOT
-			default:
-				log.Fatal().Msgf("unexpected rune %v in line %v while parsing file to gardenData", r, line)
-			}
-		}
-		yPosition += 1
+// Garden runes are kept as runes while parsing so the start rune can still be found
+func parseGardenRune(r rune) (rune, error) {
+	if _, ok := runeToSurfaceTypeMap[r]; !ok {
+		return 0, fmt.Errorf("unexpected garden rune %q", r)
 	}
-	return newGarden
+	return r, nil
 }
 
-func (garden GardenData) mapCoordinateIntoBaseRange(coord coordinate) coordinate {
-	return coordinate{
-		X: ((coord.X % garden.MapWidth) + garden.MapWidth) % garden.MapWidth,
-		Y: ((coord.Y % garden.MapHeight) + garden.MapHeight) % garden.MapHeight,
+func ParseFileToGardenData(fileScanner *bufio.Scanner) (GardenData, error) {
+	gardenRunes, err := grid.Parse(DAY, fileScanner, parseGardenRune)
+	if err != nil {
+		return GardenData{}, err
+	}
+
+	startCoordinates := gardenRunes.Find(func(r rune) bool { return r == START_RUNE })
+	if len(startCoordinates) != 1 {
+		return GardenData{}, errors.New("expected exactly one start rune in garden")
 	}
+
+	newGarden := GardenData{
+		SurfaceData:     grid.New[SurfaceTypeEnum](gardenRunes.Width, gardenRunes.Height),
+		StartCoordinate: startCoordinates[0],
+	}
+	for y := 0; y < gardenRunes.Height; y += 1 {
+		for x, r := range gardenRunes.Row(y) {
+			newGarden.SurfaceData.Set(grid.Point{X: x, Y: y}, runeToSurfaceTypeMap[r])
+		}
+	}
+	return newGarden, nil
 }
 
 func (garden GardenData) DebugLog() {
 	log.Debug().
 		Interface("StartCoordinate", garden.StartCoordinate).
-		Int("NumCoordinatesMapped", len(garden.SurfaceData)).
-		Int("MapWidth", garden.MapWidth).
-		Int("MapHeight", garden.MapHeight).
+		Int("MapWidth", garden.SurfaceData.Width).
+		Int("MapHeight", garden.SurfaceData.Height).
 		Msg("GardenDebug")
 }
 
 func (garden GardenData) NumReachableGardensInExactlyNumSteps(maxSteps int) int {
 	PRESENCE_INDICATOR := struct{}{}
-	DIRECTIONS := []DirectionEnum{DIRECTION_UP, DIRECTION_RIGHT, DIRECTION_DOWN, DIRECTION_LEFT}
 
 	// The set of all coordinates that *can* be reached in the next step
-	var nextPlots map[coordinate]interface{}
-	var currentPlots map[coordinate]interface{}
+	var nextPlots map[grid.Point]interface{}
+	var currentPlots map[grid.Point]interface{}
 
 	// The set of all gardens that were reached by the current step
-	nextPlots = map[coordinate]interface{}{
+	nextPlots = map[grid.Point]interface{}{
 		garden.StartCoordinate: PRESENCE_INDICATOR,
 	}
 
-	for stepNumber := 0; stepNumber <= maxSteps; stepNumber += 1 {
+	for stepNumber := 0; stepNumber < maxSteps; stepNumber += 1 {
 		currentPlots = nextPlots
-		nextPlots = make(map[coordinate]interface{})
+		nextPlots = make(map[grid.Point]interface{})
 
 		log.Debug().
 			Int("CurrentStepNumber", stepNumber).
@@ -93,17 +98,16 @@ func (garden GardenData) NumReachableGardensInExactlyNumSteps(maxSteps int) int
 			Send()
 
 		for coord := range currentPlots {
-			for _, direction := range DIRECTIONS {
+			for _, direction := range grid.CARDINAL_DIRECTIONS {
 				nextCoord := coord.Move(direction)
 
-				nextStep, ok := garden.SurfaceData[garden.mapCoordinateIntoBaseRange(nextCoord)]
-				if nextStep != SURFACE_PLOT || !ok {
+				if garden.SurfaceData.GetWrapped(nextCoord) != SURFACE_PLOT {
 					continue
 				}
 				log.Trace().
 					Int("StepNumber", stepNumber).
 					Interface("CurrentCoord", coord).
-					Interface("MappedCoord", garden.mapCoordinateIntoBaseRange(nextCoord)).
+					Interface("MappedCoord", garden.SurfaceData.Wrap(nextCoord)).
 					Str("MovementDirection", direction.String()).
 					Msg("FoundPlot")
 
@@ -115,38 +119,42 @@ func (garden GardenData) NumReachableGardensInExactlyNumSteps(maxSteps int) int
 	return len(nextPlots)
 }
 
-func (garden GardenData) FindNewPlotsAtValues(probeValues []int) []int {
+// Find the number of plots reachable in exactly each of the given number of steps
+//
+// Stops early with the context error if the context is cancelled
+func (garden GardenData) FindNewPlotsAtValues(ctx context.Context, probeValues []int) ([]int, error) {
 	slices.Sort(probeValues)
 	results := make([]int, 0)
 
 	PRESENCE_INDICATOR := struct{}{}
-	DIRECTIONS := []DirectionEnum{DIRECTION_UP, DIRECTION_RIGHT, DIRECTION_DOWN, DIRECTION_LEFT}
 
 	// The set of all coordinates that *can* be reached in the next step
-	var nextPlots map[coordinate]interface{}
-	var currentPlots map[coordinate]interface{}
+	var nextPlots map[grid.Point]interface{}
+	var currentPlots map[grid.Point]interface{}
 
 	// The set of all gardens that were reached by the current step
-	nextPlots = map[coordinate]interface{}{
+	nextPlots = map[grid.Point]interface{}{
 		garden.StartCoordinate: PRESENCE_INDICATOR,
 	}
 
 	for stepNumber := 0; stepNumber <= probeValues[len(probeValues)-1]; stepNumber += 1 {
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
 		currentPlots = nextPlots
-		nextPlots = make(map[coordinate]interface{})
+		nextPlots = make(map[grid.Point]interface{})
 
 		for coord := range currentPlots {
-			for _, direction := range DIRECTIONS {
+			for _, direction := range grid.CARDINAL_DIRECTIONS {
 				nextCoord := coord.Move(direction)
 
-				nextStep, ok := garden.SurfaceData[garden.mapCoordinateIntoBaseRange(nextCoord)]
-				if nextStep != SURFACE_PLOT || !ok {
+				if garden.SurfaceData.GetWrapped(nextCoord) != SURFACE_PLOT {
 					continue
 				}
 				log.Trace().
 					Int("StepNumber", stepNumber).
 					Interface("CurrentCoord", coord).
-					Interface("MappedCoord", garden.mapCoordinateIntoBaseRange(nextCoord)).
+					Interface("MappedCoord", garden.SurfaceData.Wrap(nextCoord)).
 					Str("MovementDirection", direction.String()).
 					Msg("FoundPlot")
 
@@ -163,5 +171,5 @@ func (garden GardenData) FindNewPlotsAtValues(probeValues []int) []int {
 		}
 	}
 
-	return results
+	return results, nil
 }
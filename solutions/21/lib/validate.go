@@ -0,0 +1,18 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+)
+
+// Check the garden is rectangular and made of plots and rocks, with exactly one start
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+
+	if _, err := ParseFileToGardenData(fileScanner); err != nil {
+		report.Add(err)
+	}
+
+	return report.Violations
+}
@@ -6,7 +6,10 @@ import (
 )
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	garden := lib.ParseFileToGardenData(*fileScanner)
+	garden, err := lib.ParseFileToGardenData(fileScanner)
+	if err != nil {
+		return 0, err
+	}
 	garden.DebugLog()
 	numPlots := garden.NumReachableGardensInExactlyNumSteps(64)
 
@@ -2,6 +2,7 @@ package part02
 
 import (
 	"bufio"
+	"context"
 	"hmcalister/aoc21/lib"
 
 	"github.com/openacid/slimarray/polyfit"
@@ -9,7 +10,14 @@ import (
 )
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	garden := lib.ParseFileToGardenData(*fileScanner)
+	return ProcessInputContext(context.Background(), fileScanner)
+}
+
+func ProcessInputContext(ctx context.Context, fileScanner *bufio.Scanner) (int, error) {
+	garden, err := lib.ParseFileToGardenData(fileScanner)
+	if err != nil {
+		return 0, err
+	}
 	garden.DebugLog()
 
 	// Since grid is square and start row/col has no rocks, {f(n), f(n+width), f(n+2*width),...} is quadratic
@@ -18,7 +26,7 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 	// Then we can construct a polynomial that fits those three values and calculate f(n+X*width) for an appropriate X
 
 	numSteps := 26501365
-	mapSize := garden.MapWidth
+	mapSize := garden.SurfaceData.Width
 	n := numSteps % mapSize
 
 	log.Debug().
@@ -29,7 +37,10 @@ func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
 		Send()
 
 	// garden.NumReachableGardensInExactlyNumSteps(70)
-	results := garden.FindNewPlotsAtValues([]int{n, n + mapSize, n + 2*mapSize})
+	results, err := garden.FindNewPlotsAtValues(ctx, []int{n, n + mapSize, n + 2*mapSize})
+	if err != nil {
+		return 0, err
+	}
 
 	xVals := []float64{0.0, 1.0, 2.0}
 	yVals := []float64{float64(results[0]), float64(results[1]), float64(results[2])}
@@ -0,0 +1,15 @@
+package part02
+
+import (
+	"hmcalister/aocCommon/golden"
+	"testing"
+)
+
+func TestProcessInput(t *testing.T) {
+	t.Skip("no examples: the example of the puzzle has rocks in the row and column of the start, which ProcessInput relies on being clear")
+	golden.TestSolver(t, ProcessInput)
+}
+
+func BenchmarkProcessInput(b *testing.B) {
+	golden.BenchmarkSolver(b, ProcessInput)
+}
@@ -1,25 +1,41 @@
 package lib
 
 import (
+	"errors"
 	"fmt"
+	"hmcalister/aocCommon/parse"
 	"strings"
 
 	"github.com/rs/zerolog/log"
 )
 
+const DAY = 22
+
 type BrickData struct {
 	ID      int
 	Start   Coordinate
 	Lengths Coordinate
 }
 
-func ParseLineToBrick(line string) BrickData {
-	lineFields := strings.FieldsFunc(line, func(r rune) bool {
-		return r == '~'
-	})
-	brickStarts := StringToCoordinate(lineFields[0])
-	brickEnds := StringToCoordinate(lineFields[1])
-	parsedBrick := createBrickFromCoordinates(brickStarts, brickEnds)
+// Parse a line of the form "1,0,1~1,2,1", being the coordinates of the two ends of the brick
+func ParseLineToBrick(line string, lineNumber int) (BrickData, error) {
+	tildeIndex := strings.IndexRune(line, '~')
+	if tildeIndex == -1 {
+		return BrickData{}, parse.Errorf(DAY, lineNumber, 1, line, "expected brick ends separated by \"~\"")
+	}
+	lineFields := []string{line[:tildeIndex], line[tildeIndex+1:]}
+	brickStarts, err := StringToCoordinate(lineFields[0], lineNumber, 1)
+	if err != nil {
+		return BrickData{}, err
+	}
+	brickEnds, err := StringToCoordinate(lineFields[1], lineNumber, tildeIndex+2)
+	if err != nil {
+		return BrickData{}, err
+	}
+	parsedBrick, err := createBrickFromCoordinates(brickStarts, brickEnds)
+	if err != nil {
+		return BrickData{}, parse.NewError(DAY, lineNumber, 1, line, err)
+	}
 
 	log.Debug().
 		Str("RawLine", line).
@@ -27,24 +43,24 @@ func ParseLineToBrick(line string) BrickData {
 		Str("ParsedBrick", parsedBrick.String()).
 		Send()
 
-	return parsedBrick
+	return parsedBrick, nil
 }
 
-func createBrickFromCoordinates(brickStart, brickEnd Coordinate) BrickData {
+func createBrickFromCoordinates(brickStart, brickEnd Coordinate) (BrickData, error) {
 	brickLens := Coordinate{
 		X: brickEnd.X - brickStart.X + 1,
 		Y: brickEnd.Y - brickStart.Y + 1,
 		Z: brickEnd.Z - brickStart.Z + 1,
 	}
 
-	if brickLens.X < 0 || brickLens.Y < 0 || brickLens.Z < 0 {
-		log.Fatal().Interface("BrickLens", brickLens).Msg("found negative brick len")
+	if brickLens.X < 1 || brickLens.Y < 1 || brickLens.Z < 1 {
+		return BrickData{}, errors.New("brick end is before brick start")
 	}
 
 	return BrickData{
 		Start:   brickStart,
 		Lengths: brickLens,
-	}
+	}, nil
 }
 
 func (brick BrickData) enumerateXYCoordinates() []Coordinate {
@@ -3,8 +3,11 @@ package lib
 import (
 	"bufio"
 	"errors"
+	"fmt"
+	"hmcalister/aocCommon/parse"
 	"slices"
 	"sort"
+	"strings"
 
 	"github.com/rs/zerolog/log"
 )
@@ -20,9 +23,12 @@ type BrickPileData struct {
 
 	// A map from coordinate to brick index
 	CoordinateMap map[Coordinate]int
+
+	// If set, called after each brick falls with a description of the step
+	OnStep func(description string)
 }
 
-func ParseFileToBrickPile(fileScanner *bufio.Scanner) BrickPileData {
+func ParseFileToBrickPile(fileScanner *bufio.Scanner) (BrickPileData, error) {
 	pile := BrickPileData{
 		Bricks:        make([]BrickData, 0),
 		Supports:      make([][]int, 0),
@@ -30,9 +36,14 @@ func ParseFileToBrickPile(fileScanner *bufio.Scanner) BrickPileData {
 	}
 
 	var line string
+	lineNumber := 0
 	for fileScanner.Scan() {
+		lineNumber += 1
 		line = fileScanner.Text()
-		brick := ParseLineToBrick(line)
+		brick, err := ParseLineToBrick(line, lineNumber)
+		if err != nil {
+			return pile, err
+		}
 		brick.ID = len(pile.Bricks)
 
 		pile.Bricks = append(pile.Bricks, brick)
@@ -40,7 +51,7 @@ func ParseFileToBrickPile(fileScanner *bufio.Scanner) BrickPileData {
 
 		for _, coord := range brick.enumerateAllCoordinates() {
 			if _, ok := pile.CoordinateMap[coord]; ok {
-				log.Fatal().Msgf("brick collision at coordinate %v", coord)
+				return pile, parse.Errorf(DAY, lineNumber, 1, line, "brick collision at coordinate %v", coord)
 			}
 			pile.CoordinateMap[coord] = brick.ID
 		}
@@ -50,7 +61,7 @@ func ParseFileToBrickPile(fileScanner *bufio.Scanner) BrickPileData {
 		return pile.Bricks[i].Start.Z < pile.Bricks[j].Start.Z
 	})
 
-	return pile
+	return pile, nil
 }
 
 func (pile BrickPileData) brickIsSupported(brickIndex int) bool {
@@ -197,6 +208,58 @@ func (pile BrickPileData) SimulateBrickFall() {
 		log.Trace().Msgf("Moving Brick %v", unsupportedBrickIndex)
 		// Move this brick down under gravity
 		pile.moveBrickToSupportedPosition(unsupportedBrickIndex)
+		if pile.OnStep != nil {
+			pile.OnStep(fmt.Sprintf("Brick %v fell to %v", unsupportedBrickIndex, pile.Bricks[unsupportedBrickIndex].String()))
+		}
 	} // SimulateBrickFallLoop
 
 }
+
+// Format side views of the pile as drawn in the puzzle, looking along the y axis and then along the x axis.
+//
+// Bricks are labelled by letter from their index, with ? where more than one brick is in line
+func (pile BrickPileData) FormatSideViews() string {
+	if len(pile.Bricks) == 0 {
+		return ""
+	}
+	minCoord, maxCoord := pile.Bricks[0].Start, pile.Bricks[0].Start
+	for _, brick := range pile.Bricks {
+		minCoord = Coordinate{min(minCoord.X, brick.Start.X), min(minCoord.Y, brick.Start.Y), min(minCoord.Z, brick.Start.Z)}
+		maxCoord = Coordinate{
+			max(maxCoord.X, brick.Start.X+brick.Lengths.X-1),
+			max(maxCoord.Y, brick.Start.Y+brick.Lengths.Y-1),
+			max(maxCoord.Z, brick.Start.Z+brick.Lengths.Z-1),
+		}
+	}
+	width, depth, height := maxCoord.X-minCoord.X+1, maxCoord.Y-minCoord.Y+1, maxCoord.Z-minCoord.Z+1
+
+	// Each view holds a row per z, from the lowest z upwards
+	xzView := make([][]rune, height)
+	yzView := make([][]rune, height)
+	for z := 0; z < height; z += 1 {
+		xzView[z] = []rune(strings.Repeat(".", width))
+		yzView[z] = []rune(strings.Repeat(".", depth))
+	}
+	placeLabel := func(cell *rune, label rune) {
+		if *cell == '.' {
+			*cell = label
+		} else if *cell != label {
+			*cell = '?'
+		}
+	}
+	for brickIndex, brick := range pile.Bricks {
+		label := rune('A' + brickIndex%26)
+		for _, coord := range brick.enumerateAllCoordinates() {
+			placeLabel(&xzView[coord.Z-minCoord.Z][coord.X-minCoord.X], label)
+			placeLabel(&yzView[coord.Z-minCoord.Z][coord.Y-minCoord.Y], label)
+		}
+	}
+
+	var views strings.Builder
+	fmt.Fprintf(&views, "%-*v  %v\n", width, "x", "y")
+	for z := height - 1; z >= 0; z -= 1 {
+		fmt.Fprintf(&views, "%v  %v %v\n", string(xzView[z]), string(yzView[z]), z+minCoord.Z)
+	}
+	fmt.Fprintf(&views, "%v  %v\n", strings.Repeat("-", width), strings.Repeat("-", depth))
+	return views.String()
+}
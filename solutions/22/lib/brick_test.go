@@ -0,0 +1,44 @@
+package lib
+
+import (
+	"fmt"
+	"hmcalister/aocCommon/golden"
+	"testing"
+)
+
+// Print a brick as a line of the input, being the coordinates of its two ends
+func formatBrick(brick BrickData) string {
+	return fmt.Sprintf("%v,%v,%v~%v,%v,%v",
+		brick.Start.X, brick.Start.Y, brick.Start.Z,
+		brick.Start.X+brick.Lengths.X-1, brick.Start.Y+brick.Lengths.Y-1, brick.Start.Z+brick.Lengths.Z-1)
+}
+
+func FuzzParseLineToBrick(f *testing.F) {
+	lines, err := golden.ExampleLines("../part01/" + golden.TESTDATA_DIR)
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, line := range lines {
+		f.Add(line)
+	}
+
+	f.Fuzz(func(t *testing.T, line string) {
+		brick, err := ParseLineToBrick(line, 1)
+		if err != nil {
+			golden.CheckParseError(t, line, err)
+			return
+		}
+		if brick.Lengths.X < 1 || brick.Lengths.Y < 1 || brick.Lengths.Z < 1 {
+			t.Fatalf("parsed %q to brick with lengths %v", line, brick.Lengths)
+		}
+
+		printed := formatBrick(brick)
+		reparsed, err := ParseLineToBrick(printed, 1)
+		if err != nil {
+			t.Fatalf("parsed %q but failed to parse it printed as %q: %v", line, printed, err)
+		}
+		if reparsed != brick {
+			t.Errorf("parsed %q to %v but %q to %v", line, brick, printed, reparsed)
+		}
+	})
+}
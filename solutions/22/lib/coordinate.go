@@ -2,10 +2,7 @@ package lib
 
 import (
 	"fmt"
-	"strconv"
-	"strings"
-
-	"github.com/rs/zerolog/log"
+	"hmcalister/aocCommon/parse"
 )
 
 type Coordinate struct {
@@ -18,23 +15,29 @@ func (coord Coordinate) String() string {
 	return fmt.Sprintf("(%v, %v, %v)", coord.X, coord.Y, coord.Z)
 }
 
-func StringToCoordinate(str string) Coordinate {
-	fields := strings.Split(str, ",")
-	x, err := strconv.Atoi(fields[0])
+// Parse a string of the form "1,0,1" to a coordinate
+//
+// startColumn is the column of the start of str within its line
+func StringToCoordinate(str string, lineNumber int, startColumn int) (Coordinate, error) {
+	fields := parse.Split(str, ",", startColumn)
+	if len(fields) != 3 {
+		return Coordinate{}, parse.Errorf(DAY, lineNumber, startColumn, str, "expected 3 comma separated values, found %v", len(fields))
+	}
+	x, err := parse.Atoi(DAY, lineNumber, fields[0])
 	if err != nil {
-		log.Fatal().Msgf("failed to convert field %v to integer in string %v", fields[0], str)
+		return Coordinate{}, err
 	}
-	y, err := strconv.Atoi(fields[1])
+	y, err := parse.Atoi(DAY, lineNumber, fields[1])
 	if err != nil {
-		log.Fatal().Msgf("failed to convert field %v to integer in string %v", fields[1], str)
+		return Coordinate{}, err
 	}
-	z, err := strconv.Atoi(fields[2])
+	z, err := parse.Atoi(DAY, lineNumber, fields[2])
 	if err != nil {
-		log.Fatal().Msgf("failed to convert field %v to integer in string %v", fields[2], str)
+		return Coordinate{}, err
 	}
 	return Coordinate{
 		x,
 		y,
 		z,
-	}
+	}, nil
 }
@@ -0,0 +1,18 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+)
+
+// Check each line is a brick like "1,0,1~1,2,1", and that no two bricks overlap
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+
+	if _, err := ParseFileToBrickPile(fileScanner); err != nil {
+		report.Add(err)
+	}
+
+	return report.Violations
+}
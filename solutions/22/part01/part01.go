@@ -2,13 +2,33 @@ package part01
 
 import (
 	"bufio"
+	"hmcalister/aocCommon/stepview"
 	"hmcalister/aox22/lib"
 
 	"github.com/rs/zerolog/log"
 )
 
+// Optional outputs of ProcessInputWithOptions, beyond the answer
+type Options struct {
+	// If not nil, record side views of the pile after each brick falls to it
+	Recorder *stepview.Recorder
+}
+
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	pile := lib.ParseFileToBrickPile(fileScanner)
+	return ProcessInputWithOptions(fileScanner, Options{})
+}
+
+func ProcessInputWithOptions(fileScanner *bufio.Scanner, options Options) (int, error) {
+	pile, err := lib.ParseFileToBrickPile(fileScanner)
+	if err != nil {
+		return 0, err
+	}
+	if options.Recorder != nil {
+		pile.OnStep = func(description string) {
+			options.Recorder.Record(description, pile.FormatSideViews)
+		}
+		pile.OnStep("Initial pile")
+	}
 	pile.SimulateBrickFall()
 
 	disintegrateBrickIndicesMap := make(map[int]bool)
@@ -1,26 +1,34 @@
 package lib
 
 import (
-	"github.com/dominikbraun/graph"
+	"context"
+	"errors"
+	"fmt"
+	"hmcalister/aocCommon/graphalg"
+	"hmcalister/aocCommon/grid"
+
 	"github.com/rs/zerolog/log"
 )
 
+// The longest path search proxies the start and end by the one junction each connects to
+var ErrTrailEndJunctions = errors.New("expected the start and end to each connect to exactly one junction")
+
 type CondensedTrailData struct {
-	TrailGraph      graph.Graph[string, Coordinate]
-	startCoordinate Coordinate
-	endCoordinate   Coordinate
+	TrailGraph      *graphalg.Graph[grid.Point]
+	startCoordinate grid.Point
+	endCoordinate   grid.Point
 }
 
 type GraphTraversalData struct {
-	CurrentVertex   string
-	VisitedVertices map[string]interface{}
+	CurrentVertex   grid.Point
+	VisitedVertices map[grid.Point]interface{}
 	TotalDistance   int
 }
 
-func (node GraphTraversalData) NextGraphTraversalData(nextVertex string, edgeWeight int) GraphTraversalData {
+func (node GraphTraversalData) NextGraphTraversalData(nextVertex grid.Point, edgeWeight int) GraphTraversalData {
 	nextNode := GraphTraversalData{
 		CurrentVertex:   nextVertex,
-		VisitedVertices: make(map[string]interface{}),
+		VisitedVertices: make(map[grid.Point]interface{}),
 		TotalDistance:   node.TotalDistance + edgeWeight,
 	}
 	for k, v := range node.VisitedVertices {
@@ -32,24 +40,19 @@ func (node GraphTraversalData) NextGraphTraversalData(nextVertex string, edgeWei
 }
 
 func ConvertTrailDataToCondensedTrailData(trailData *TrailData) *CondensedTrailData {
-	// Define the new graph hash function
-	coordinateHash := func(coord Coordinate) string {
-		return coord.String()
-	}
-
 	condensedTrail := &CondensedTrailData{
-		TrailGraph:      graph.New(coordinateHash, graph.Weighted()),
+		TrailGraph:      graphalg.NewGraph[grid.Point](),
 		startCoordinate: trailData.startCoordinate,
 		endCoordinate:   trailData.endCoordinate,
 	}
 
 	// actually create the new graph from the TrailMap
 
-	getValidMovementDirections := func(coord Coordinate, trailMap map[Coordinate]SurfaceTypeEnum) []DirectionEnum {
-		validDirections := make([]DirectionEnum, 0)
-		for _, direction := range []DirectionEnum{DIRECTION_UP, DIRECTION_RIGHT, DIRECTION_DOWN, DIRECTION_LEFT} {
+	getValidMovementDirections := func(coord grid.Point, trailMap *grid.Grid[SurfaceTypeEnum]) []grid.DirectionEnum {
+		validDirections := make([]grid.DirectionEnum, 0)
+		for _, direction := range grid.CARDINAL_DIRECTIONS {
 			nextCoord := coord.Move(direction)
-			surfaceType, isInTrailMap := trailMap[nextCoord]
+			surfaceType, isInTrailMap := trailMap.Lookup(nextCoord)
 			if isInTrailMap && surfaceType != SURFACE_FOREST {
 				validDirections = append(validDirections, direction)
 			}
@@ -62,7 +65,7 @@ func ConvertTrailDataToCondensedTrailData(trailData *TrailData) *CondensedTrailD
 	type graphConstructionStruct struct {
 		PathNodeData
 
-		previousVertexCoordinate   Coordinate
+		previousVertexCoordinate   grid.Point
 		distanceFromPreviousVertex int
 	}
 
@@ -72,10 +75,9 @@ func ConvertTrailDataToCondensedTrailData(trailData *TrailData) *CondensedTrailD
 	condensedTrail.TrailGraph.AddVertex(trailData.startCoordinate)
 	condensedTrail.TrailGraph.AddVertex(trailData.endCoordinate)
 
-	for y := 0; y < trailData.mapHeight; y += 1 {
-		for x := 0; x < trailData.mapWidth; x += 1 {
-			currentCoord := Coordinate{x, y}
-			surface := trailData.trailMap[currentCoord]
+	for y := 0; y < trailData.trailMap.Height; y += 1 {
+		for x, surface := range trailData.trailMap.Row(y) {
+			currentCoord := grid.Point{X: x, Y: y}
 			if surface == SURFACE_FOREST {
 				continue
 			}
@@ -92,7 +94,7 @@ func ConvertTrailDataToCondensedTrailData(trailData *TrailData) *CondensedTrailD
 					graphConstructionQueue = append(graphConstructionQueue, graphConstructionStruct{
 						PathNodeData: PathNodeData{
 							currentCoordinate: currentCoord.Move(direction),
-							visitedCoordinates: map[Coordinate]interface{}{
+							visitedCoordinates: map[grid.Point]interface{}{
 								currentCoord: visitedCoordinatePresenceIndicator,
 							},
 						},
@@ -109,23 +111,23 @@ func ConvertTrailDataToCondensedTrailData(trailData *TrailData) *CondensedTrailD
 		currentGraphTraversalData, graphConstructionQueue = graphConstructionQueue[len(graphConstructionQueue)-1], graphConstructionQueue[:len(graphConstructionQueue)-1]
 
 		// Check if this traversal has reached a new vertex
-		if _, err := condensedTrail.TrailGraph.Vertex(currentGraphTraversalData.currentCoordinate.String()); err == nil {
+		if condensedTrail.TrailGraph.HasVertex(currentGraphTraversalData.currentCoordinate) {
 			log.Debug().
 				Str("PreviousVertexCoord", currentGraphTraversalData.previousVertexCoordinate.String()).
 				Str("CurrentVertexCoord", currentGraphTraversalData.currentCoordinate.String()).
 				Int("EdgeLength", currentGraphTraversalData.distanceFromPreviousVertex).
 				Msg("EdgeFound")
-			condensedTrail.TrailGraph.AddEdge(currentGraphTraversalData.previousVertexCoordinate.String(), currentGraphTraversalData.currentCoordinate.String(), graph.EdgeWeight(currentGraphTraversalData.distanceFromPreviousVertex))
+			condensedTrail.TrailGraph.AddUndirectedEdge(currentGraphTraversalData.previousVertexCoordinate, currentGraphTraversalData.currentCoordinate, currentGraphTraversalData.distanceFromPreviousVertex)
 			continue
 		}
 
-		for _, direction := range []DirectionEnum{DIRECTION_UP, DIRECTION_RIGHT, DIRECTION_DOWN, DIRECTION_LEFT} {
+		for _, direction := range grid.CARDINAL_DIRECTIONS {
 			nextCoord := currentGraphTraversalData.currentCoordinate.Move(direction)
 			if _, alreadyVisited := currentGraphTraversalData.visitedCoordinates[nextCoord]; alreadyVisited {
 				continue
 			}
 
-			nextSurfaceType, nextCoordInTrailMap := trailData.trailMap[nextCoord]
+			nextSurfaceType, nextCoordInTrailMap := trailData.trailMap.Lookup(nextCoord)
 			if !nextCoordInTrailMap || nextSurfaceType == SURFACE_FOREST {
 				continue
 			}
@@ -143,48 +145,48 @@ func ConvertTrailDataToCondensedTrailData(trailData *TrailData) *CondensedTrailD
 	return condensedTrail
 }
 
-func (condensedTrail *CondensedTrailData) FindPathNonSlippery() int {
+// Find the length of the longest path from start to end, visiting each vertex at most once
+//
+// The search is exhaustive, so stops early with the context error if the context is cancelled
+func (condensedTrail *CondensedTrailData) FindPathNonSlippery(ctx context.Context) (int, error) {
 
-	adjacencyMap, _ := condensedTrail.TrailGraph.AdjacencyMap()
 	bestFinishPathLen := -1
 
 	// It appears the start node and end node connect to exactly one other node
 	// So use those other nodes as proxy start/end and just add the additional length
 
-	additionalDistance := 0
-	var proxyStartVertex string
-	var proxyEndVertex string
-
-	if len(adjacencyMap[condensedTrail.startCoordinate.String()]) != 1 {
-		log.Fatal().Msg("start vertex has more than one neighbor")
+	startEdges := condensedTrail.TrailGraph.Neighbors(condensedTrail.startCoordinate)
+	if len(startEdges) != 1 {
+		return bestFinishPathLen, fmt.Errorf("%w: the start connects to %v", ErrTrailEndJunctions, len(startEdges))
 	}
-	for k, v := range adjacencyMap[condensedTrail.startCoordinate.String()] {
-		proxyStartVertex = k
-		additionalDistance += v.Properties.Weight
-		log.Debug().Str("ProxyStartVertex", proxyStartVertex).Send()
-	}
-	if len(adjacencyMap[condensedTrail.endCoordinate.String()]) != 1 {
-		log.Fatal().Msg("end vertex has more than one neighbor")
-	}
-	for k, v := range adjacencyMap[condensedTrail.endCoordinate.String()] {
-		proxyEndVertex = k
-		additionalDistance += v.Properties.Weight
-		log.Debug().Str("ProxyEndVertex", proxyEndVertex).Send()
+	proxyStartVertex := startEdges[0].To
+	log.Debug().Str("ProxyStartVertex", proxyStartVertex.String()).Send()
+
+	endEdges := condensedTrail.TrailGraph.Neighbors(condensedTrail.endCoordinate)
+	if len(endEdges) != 1 {
+		return bestFinishPathLen, fmt.Errorf("%w: the end connects to %v", ErrTrailEndJunctions, len(endEdges))
 	}
+	proxyEndVertex := endEdges[0].To
+	log.Debug().Str("ProxyEndVertex", proxyEndVertex.String()).Send()
+
+	additionalDistance := startEdges[0].Weight + endEdges[0].Weight
 
 	graphTraversalList := make([]GraphTraversalData, 0)
 	graphTraversalList = append(graphTraversalList, GraphTraversalData{
 		CurrentVertex:   proxyStartVertex,
-		VisitedVertices: make(map[string]interface{}),
+		VisitedVertices: make(map[grid.Point]interface{}),
 		TotalDistance:   additionalDistance,
 	})
 
 	var currentTraversalData GraphTraversalData
 	for len(graphTraversalList) > 0 {
+		if err := ctx.Err(); err != nil {
+			return bestFinishPathLen, err
+		}
 		currentTraversalData, graphTraversalList = graphTraversalList[len(graphTraversalList)-1], graphTraversalList[:len(graphTraversalList)-1]
 
 		log.Debug().
-			Str("CurrentCoord", currentTraversalData.CurrentVertex).
+			Str("CurrentCoord", currentTraversalData.CurrentVertex.String()).
 			Int("CurrentPathLen", currentTraversalData.TotalDistance).
 			Int("QueueLength", len(graphTraversalList)).
 			Int("BestFinishPathLength", bestFinishPathLen).
@@ -204,16 +206,15 @@ func (condensedTrail *CondensedTrailData) FindPathNonSlippery() int {
 			continue
 		}
 
-		neighborsMap := adjacencyMap[currentTraversalData.CurrentVertex]
-		for neighborVertex, edgeWeight := range neighborsMap {
-			if _, ok := currentTraversalData.VisitedVertices[neighborVertex]; ok {
+		for _, edge := range condensedTrail.TrailGraph.Neighbors(currentTraversalData.CurrentVertex) {
+			if _, ok := currentTraversalData.VisitedVertices[edge.To]; ok {
 				continue
 			}
 
-			nextTraversal := currentTraversalData.NextGraphTraversalData(neighborVertex, edgeWeight.Properties.Weight)
+			nextTraversal := currentTraversalData.NextGraphTraversalData(edge.To, edge.Weight)
 			graphTraversalList = append(graphTraversalList, nextTraversal)
 		}
 	}
 
-	return bestFinishPathLen
+	return bestFinishPathLen, nil
 }
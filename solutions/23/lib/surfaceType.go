@@ -1,5 +1,10 @@
 package lib
 
+import (
+	"hmcalister/aocCommon/render"
+	"image/color"
+)
+
 //go:generate stringer -type SurfaceTypeEnum
 type SurfaceTypeEnum int
 
@@ -31,3 +36,17 @@ var (
 		SURFACE_SLOPE_LEFT:  '<',
 	}
 )
+
+var (
+	surfacePalette = render.Palette[SurfaceTypeEnum]{
+		SURFACE_FOREST:      color.RGBA{0x20, 0x50, 0x20, 0xff},
+		SURFACE_PATH:        color.RGBA{0xc0, 0xb0, 0x90, 0xff},
+		SURFACE_SLOPE_UP:    color.RGBA{0x90, 0x80, 0x60, 0xff},
+		SURFACE_SLOPE_RIGHT: color.RGBA{0x90, 0x80, 0x60, 0xff},
+		SURFACE_SLOPE_DOWN:  color.RGBA{0x90, 0x80, 0x60, 0xff},
+		SURFACE_SLOPE_LEFT:  color.RGBA{0x90, 0x80, 0x60, 0xff},
+	}
+
+	pathColor     = color.RGBA{0xe0, 0x30, 0x30, 0xff}
+	junctionColor = color.RGBA{0x30, 0x60, 0xe0, 0xff}
+)
@@ -2,75 +2,54 @@ package lib
 
 import (
 	"bufio"
-	"container/heap"
+	"context"
 	"errors"
 	"fmt"
+	"hmcalister/aocCommon/graphalg"
+	"hmcalister/aocCommon/grid"
+	"hmcalister/aocCommon/render"
 	"sort"
 
 	"github.com/rs/zerolog/log"
 )
 
 type TrailData struct {
-	trailMap        map[Coordinate]SurfaceTypeEnum
-	startCoordinate Coordinate
-	endCoordinate   Coordinate
-	mapWidth        int
-	mapHeight       int
+	trailMap        *grid.Grid[SurfaceTypeEnum]
+	startCoordinate grid.Point
+	endCoordinate   grid.Point
 }
 
-func ParseFileToTrail(fileScanner *bufio.Scanner) *TrailData {
-	trail := &TrailData{
-		trailMap: make(map[Coordinate]SurfaceTypeEnum),
+func parseSurfaceRune(r rune) (SurfaceTypeEnum, error) {
+	surfaceType, ok := runeToSurfaceTypeMap[r]
+	if !ok {
+		return 0, fmt.Errorf("unexpected surface rune %q", r)
 	}
+	return surfaceType, nil
+}
 
-	var line string
-	for fileScanner.Scan() {
-		line = fileScanner.Text()
-
-		log.Debug().
-			Int("LineNumber", trail.mapHeight).
-			Str("RawLine", line).
-			Int("NumRunes", len(line)).
-			Send()
-
-		for runeIndex, r := range line {
-			currentCoordinate := Coordinate{
-				X: runeIndex,
-				Y: trail.mapHeight,
-			}
-
-			currentSurface, ok := runeToSurfaceTypeMap[r]
-			if !ok {
-				log.Fatal().Msgf("found unexpected surface rune(coordinate %v)  %v in line %v ", currentCoordinate.String(), r, line)
-			}
-
-			log.Trace().
-				Str("CurrentCoordinate", currentCoordinate.String()).
-				Str("CurrentSurface", currentSurface.String()).
-				Str("RawRune", string(r)).
-				Send()
-
-			trail.trailMap[currentCoordinate] = currentSurface
-		}
-
-		trail.mapWidth = max(trail.mapWidth, len(line))
-		trail.mapHeight += 1
+func ParseFileToTrail(fileScanner *bufio.Scanner) (*TrailData, error) {
+	trailMap, err := grid.Parse(DAY, fileScanner, parseSurfaceRune)
+	if err != nil {
+		return nil, err
+	}
+	trail := &TrailData{
+		trailMap: trailMap,
 	}
 
-	for x := 0; x < trail.mapWidth; x += 1 {
-		firstLineCoord := Coordinate{
+	for x := 0; x < trailMap.Width; x += 1 {
+		firstLineCoord := grid.Point{
 			X: x,
 			Y: 0,
 		}
-		if trail.trailMap[firstLineCoord] == SURFACE_PATH {
+		if trailMap.Get(firstLineCoord) == SURFACE_PATH {
 			trail.startCoordinate = firstLineCoord
 		}
 
-		lastLineCoordinate := Coordinate{
+		lastLineCoordinate := grid.Point{
 			X: x,
-			Y: trail.mapHeight - 1,
+			Y: trailMap.Height - 1,
 		}
-		if trail.trailMap[lastLineCoordinate] == SURFACE_PATH {
+		if trailMap.Get(lastLineCoordinate) == SURFACE_PATH {
 			trail.endCoordinate = lastLineCoordinate
 		}
 	}
@@ -80,30 +59,25 @@ func ParseFileToTrail(fileScanner *bufio.Scanner) *TrailData {
 		Str("EndCoordinate", trail.endCoordinate.String()).
 		Send()
 
-	return trail
+	return trail, nil
 }
 
 func (trail *TrailData) VisualizePath(path PathNodeData) {
-	line := make([]rune, trail.mapWidth)
-	trailLine := make([]rune, trail.mapWidth)
-	for y := 0; y < trail.mapHeight; y += 1 {
-		for x := 0; x < trail.mapWidth; x += 1 {
-			currentCoord := Coordinate{
+	line := make([]rune, trail.trailMap.Width)
+	trailLine := make([]rune, trail.trailMap.Width)
+	for y := 0; y < trail.trailMap.Height; y += 1 {
+		for x, surfaceType := range trail.trailMap.Row(y) {
+			currentCoord := grid.Point{
 				X: x,
 				Y: y,
 			}
 
-			surfaceType, isInTrailMap := trail.trailMap[currentCoord]
 			trailLine[x] = surfaceTypeToRuneMap[surfaceType]
-
 			if _, isInPath := path.visitedCoordinates[currentCoord]; isInPath {
 				line[x] = 'O'
-			} else if isInTrailMap {
-				line[x] = surfaceTypeToRuneMap[surfaceType]
 			} else {
-				log.Fatal().Msgf("failed to identify coordinate %v in either path or trailMap", currentCoord)
+				line[x] = surfaceTypeToRuneMap[surfaceType]
 			}
-
 		}
 		log.Info().
 			Str(fmt.Sprintf("Line %03v", y), string(line)).
@@ -112,23 +86,47 @@ func (trail *TrailData) VisualizePath(path PathNodeData) {
 	}
 }
 
-func (trail *TrailData) FindPathSlippery() (PathNodeData, error) {
-	pathNodeQueue := make(PathNodePriorityQueue, 0)
-	heap.Init(&pathNodeQueue)
+// Render the trail map to a PNG file, with the given path drawn over it
+func (trail *TrailData) RenderPath(filePath string, path PathNodeData) error {
+	canvas := render.Grid(trail.trailMap, surfacePalette, render.DEFAULT_CELL_SIZE)
+	for coordinate := range path.visitedCoordinates {
+		canvas.FillCell(coordinate, pathColor)
+	}
+	return canvas.WritePNG(filePath)
+}
+
+// Render the trail map to a PNG file, with the vertices of the condensed trail drawn over it
+func (trail *TrailData) RenderJunctions(filePath string, condensedTrail *CondensedTrailData) error {
+	canvas := render.Grid(trail.trailMap, surfacePalette, render.DEFAULT_CELL_SIZE)
+	for _, coordinate := range condensedTrail.TrailGraph.Vertices() {
+		canvas.FillCell(coordinate, junctionColor)
+	}
+	return canvas.WritePNG(filePath)
+}
+
+// Find the longest path from start to end, where slopes may only be walked downhill
+//
+// The search is exhaustive, so stops early with the context error if the context is cancelled
+func (trail *TrailData) FindPathSlippery(ctx context.Context) (PathNodeData, error) {
+	pathNodeQueue := graphalg.NewHeap(func(a, b PathNodeData) bool {
+		return len(a.visitedCoordinates) < len(b.visitedCoordinates)
+	})
 
 	startNode := PathNodeData{
 		currentCoordinate:  trail.startCoordinate,
-		visitedCoordinates: make(map[Coordinate]interface{}),
+		visitedCoordinates: make(map[grid.Point]interface{}),
 	}
 	startNode.visitedCoordinates[startNode.currentCoordinate] = visitedCoordinatePresenceIndicator
-	heap.Push(&pathNodeQueue, startNode)
+	pathNodeQueue.Push(startNode)
 
-	directions := []DirectionEnum{DIRECTION_UP, DIRECTION_RIGHT, DIRECTION_DOWN, DIRECTION_LEFT}
 	finishPathNodes := make([]PathNodeData, 0)
 
 	var currentNode PathNodeData
 	for pathNodeQueue.Len() > 0 {
-		currentNode = heap.Pop(&pathNodeQueue).(PathNodeData)
+		if err := ctx.Err(); err != nil {
+			return startNode, err
+		}
+		currentNode = pathNodeQueue.Pop()
 
 		log.Debug().
 			Str("CurrentCoord", currentNode.currentCoordinate.String()).
@@ -144,13 +142,13 @@ func (trail *TrailData) FindPathSlippery() (PathNodeData, error) {
 			continue
 		}
 
-		for _, direction := range directions {
+		for _, direction := range grid.CARDINAL_DIRECTIONS {
 			nextCoord := currentNode.currentCoordinate.Move(direction)
 			if _, alreadyVisited := currentNode.visitedCoordinates[nextCoord]; alreadyVisited {
 				continue
 			}
 
-			nextSurfaceType, nextCoordInTrailMap := trail.trailMap[nextCoord]
+			nextSurfaceType, nextCoordInTrailMap := trail.trailMap.Lookup(nextCoord)
 			if !nextCoordInTrailMap {
 				continue
 			}
@@ -159,19 +157,19 @@ func (trail *TrailData) FindPathSlippery() (PathNodeData, error) {
 			case SURFACE_FOREST:
 				continue
 			case SURFACE_SLOPE_UP:
-				if direction != DIRECTION_UP {
+				if direction != grid.DIRECTION_UP {
 					continue
 				}
 			case SURFACE_SLOPE_RIGHT:
-				if direction != DIRECTION_RIGHT {
+				if direction != grid.DIRECTION_RIGHT {
 					continue
 				}
 			case SURFACE_SLOPE_DOWN:
-				if direction != DIRECTION_DOWN {
+				if direction != grid.DIRECTION_DOWN {
 					continue
 				}
 			case SURFACE_SLOPE_LEFT:
-				if direction != DIRECTION_LEFT {
+				if direction != grid.DIRECTION_LEFT {
 					continue
 				}
 			case SURFACE_PATH:
@@ -185,7 +183,7 @@ func (trail *TrailData) FindPathSlippery() (PathNodeData, error) {
 				Msg("NewNodeAdded")
 
 			nextNode := currentNode.NextPathNode(direction)
-			heap.Push(&pathNodeQueue, nextNode)
+			pathNodeQueue.Push(nextNode)
 		}
 	}
 
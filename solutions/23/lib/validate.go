@@ -0,0 +1,41 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+	"strings"
+)
+
+const DAY = 23
+
+// Check the trail is rectangular and made of known surfaces, with exactly one gap in the
+// first and last lines to be the start and end of the hike
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+	lines := validate.ReadLines(fileScanner)
+
+	report.CheckUniformWidth(lines)
+	if len(lines) < 2 {
+		report.Addf(0, 0, "", "found %v lines, expected at least 2", len(lines))
+		return report.Violations
+	}
+
+	allowedRunes := ""
+	for surface := SURFACE_FOREST; surface <= SURFACE_SLOPE_LEFT; surface += 1 {
+		allowedRunes += string(surfaceTypeToRuneMap[surface])
+	}
+	for lineIndex, line := range lines {
+		report.CheckRunes(line, lineIndex+1, allowedRunes)
+	}
+
+	pathRune := string(surfaceTypeToRuneMap[SURFACE_PATH])
+	for _, lineIndex := range []int{0, len(lines) - 1} {
+		line := lines[lineIndex]
+		if numGaps := strings.Count(line, pathRune); numGaps != 1 {
+			report.Addf(lineIndex+1, 0, line, "found %v gaps in the boundary line, expected exactly 1", numGaps)
+		}
+	}
+
+	return report.Violations
+}
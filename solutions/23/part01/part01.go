@@ -2,16 +2,39 @@ package part01
 
 import (
 	"bufio"
+	"context"
 	"hmcalister/aoc23/lib"
 )
 
+// Optional outputs of ProcessInputWithOptions, beyond the answer
+type Options struct {
+	// If not empty, render the trail and the longest path to a PNG file at this path
+	RenderPath string
+}
+
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	trail := lib.ParseFileToTrail(fileScanner)
-	path, err := trail.FindPathSlippery()
+	return ProcessInputContext(context.Background(), fileScanner)
+}
+
+func ProcessInputContext(ctx context.Context, fileScanner *bufio.Scanner) (int, error) {
+	return ProcessInputWithOptions(ctx, fileScanner, Options{})
+}
+
+func ProcessInputWithOptions(ctx context.Context, fileScanner *bufio.Scanner, options Options) (int, error) {
+	trail, err := lib.ParseFileToTrail(fileScanner)
+	if err != nil {
+		return -1, err
+	}
+	path, err := trail.FindPathSlippery(ctx)
 	if err != nil {
 		return -1, err
 	}
 	trail.VisualizePath(path)
+	if options.RenderPath != "" {
+		if err := trail.RenderPath(options.RenderPath, path); err != nil {
+			return -1, err
+		}
+	}
 
 	return path.PathLength(), nil
 }
@@ -2,16 +2,43 @@ package part02
 
 import (
 	"bufio"
+	"context"
 	"hmcalister/aoc23/lib"
 )
 
+// Optional outputs of ProcessInputWithOptions, beyond the answer
+type Options struct {
+	// If not empty, render the trail and the junctions between its stretches to a PNG file at this path.
+	// The longest path itself is not kept, only its length
+	RenderPath string
+}
+
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	trail := lib.ParseFileToTrail(fileScanner)
+	return ProcessInputContext(context.Background(), fileScanner)
+}
+
+func ProcessInputContext(ctx context.Context, fileScanner *bufio.Scanner) (int, error) {
+	return ProcessInputWithOptions(ctx, fileScanner, Options{})
+}
+
+func ProcessInputWithOptions(ctx context.Context, fileScanner *bufio.Scanner, options Options) (int, error) {
+	trail, err := lib.ParseFileToTrail(fileScanner)
+	if err != nil {
+		return -1, err
+	}
 	condensedTrail := lib.ConvertTrailDataToCondensedTrailData(trail)
 	// file, _ := os.Create("./graphVis.gv")
 	// draw.DOT(condensedTrail.TrailGraph, file)
+	if options.RenderPath != "" {
+		if err := trail.RenderJunctions(options.RenderPath, condensedTrail); err != nil {
+			return -1, err
+		}
+	}
 
-	longestPath := condensedTrail.FindPathNonSlippery()
+	longestPath, err := condensedTrail.FindPathNonSlippery(ctx)
+	if err != nil {
+		return -1, err
+	}
 
 	return longestPath, nil
 }
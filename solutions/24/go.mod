@@ -5,6 +5,7 @@ go 1.21.0
 require (
 	github.com/rs/zerolog v1.31.0
 	gonum.org/v1/gonum v0.14.0
+	hmcalister/aocCommon v0.0.0
 )
 
 require (
@@ -12,3 +13,5 @@ require (
 	github.com/mattn/go-isatty v0.0.19 // indirect
 	golang.org/x/sys v0.12.0 // indirect
 )
+
+replace hmcalister/aocCommon => ../../common
@@ -3,12 +3,15 @@ package lib
 import (
 	"errors"
 	"fmt"
+	"hmcalister/aocCommon/parse"
 	"strings"
 
 	"github.com/rs/zerolog/log"
 	"gonum.org/v1/gonum/mat"
 )
 
+const DAY = 24
+
 type HailstoneData struct {
 	Position *mat.VecDense
 	Velocity *mat.VecDense
@@ -18,34 +21,25 @@ func (hailstone HailstoneData) String() string {
 	return fmt.Sprintf("%v, %v", vectorToString(hailstone.Position), vectorToString(hailstone.Velocity))
 }
 
-func parseLineToHailstone(line string) HailstoneData {
+func parseLineToHailstone(line string, lineNumber int) (HailstoneData, error) {
 	ampersandIndex := strings.IndexRune(line, '@')
 	if ampersandIndex == -1 {
-		log.Fatal().Msgf("failed to find ampersand in line %v", line)
+		return HailstoneData{}, parse.Errorf(DAY, lineNumber, 1, line, "failed to find ampersand")
 	}
 
-	positionFields := strings.Split(line[:ampersandIndex], ", ")
-	velocityFields := strings.Split(line[ampersandIndex+1:], ", ")
-
-	positionVec, err := parseFieldsToVector(positionFields)
+	positionVec, err := parseStringToVector(line[:ampersandIndex], lineNumber, 1)
 	if err != nil {
-		log.Fatal().
-			Str("Line", line).
-			Interface("PositionFields", positionFields).
-			Msg(err.Error())
+		return HailstoneData{}, err
 	}
-	velocityVec, err := parseFieldsToVector(velocityFields)
+	velocityVec, err := parseStringToVector(line[ampersandIndex+1:], lineNumber, ampersandIndex+2)
 	if err != nil {
-		log.Fatal().
-			Str("Line", line).
-			Interface("VelocityFields", velocityFields).
-			Msg(err.Error())
+		return HailstoneData{}, err
 	}
 
 	return HailstoneData{
 		Position: positionVec,
 		Velocity: velocityVec,
-	}
+	}, nil
 }
 
 func (hailstone HailstoneData) FindPathIntersectionPositionInXY(secondHailstone HailstoneData) (*mat.VecDense, error) {
@@ -0,0 +1,23 @@
+package lib
+
+import (
+	"hmcalister/aocCommon/golden"
+	"testing"
+)
+
+func FuzzParseLineToHailstone(f *testing.F) {
+	f.Add("19, 13, 30 @ -2,  1, -2")
+	f.Add("18, 19, 22 @ -1, -1, -2")
+	f.Add("20, 25, 34 @ -2, -2, -4")
+
+	f.Fuzz(func(t *testing.T, line string) {
+		hailstone, err := parseLineToHailstone(line, 1)
+		if err != nil {
+			golden.CheckParseError(t, line, err)
+			return
+		}
+		if hailstone.Position.Len() != 3 || hailstone.Velocity.Len() != 3 {
+			t.Errorf("parsed %q to hailstone %v", line, hailstone)
+		}
+	})
+}
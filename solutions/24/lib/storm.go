@@ -10,12 +10,17 @@ type StormData struct {
 	hailstoneCollection []HailstoneData
 }
 
-func ParseFileToStorm(fileScanner *bufio.Scanner) StormData {
+func ParseFileToStorm(fileScanner *bufio.Scanner) (StormData, error) {
 	hailstoneCollection := make([]HailstoneData, 0)
 
+	lineNumber := 0
 	for fileScanner.Scan() {
+		lineNumber += 1
 		line := fileScanner.Text()
-		nextHailstone := parseLineToHailstone(line)
+		nextHailstone, err := parseLineToHailstone(line, lineNumber)
+		if err != nil {
+			return StormData{}, err
+		}
 
 		log.Debug().
 			Str("RawLine", line).
@@ -27,7 +32,7 @@ func ParseFileToStorm(fileScanner *bufio.Scanner) StormData {
 
 	return StormData{
 		hailstoneCollection: hailstoneCollection,
-	}
+	}, nil
 }
 
 func (storm StormData) PathIntersectionInXY(minimumPositionBound, maximumPositionBound float64) int {
@@ -0,0 +1,18 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+)
+
+// Check each line is a hailstone position and velocity like "19, 13, 30 @ -2,  1, -2"
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+
+	if _, err := ParseFileToStorm(fileScanner); err != nil {
+		report.Add(err)
+	}
+
+	return report.Violations
+}
@@ -1,10 +1,8 @@
 package lib
 
 import (
-	"errors"
 	"fmt"
-	"strconv"
-	"strings"
+	"hmcalister/aocCommon/parse"
 
 	"gonum.org/v1/gonum/mat"
 )
@@ -14,24 +12,28 @@ func vectorToString(v *mat.VecDense) string {
 	return fmt.Sprintf("(%v, %v, %v)", v.AtVec(0), v.AtVec(1), v.AtVec(2))
 }
 
-func parseFieldsToVector(fields []string) (*mat.VecDense, error) {
+// Parse a string of the form "19, 13, 30" to a vector
+//
+// startColumn is the column of the start of str within its line
+func parseStringToVector(str string, lineNumber int, startColumn int) (*mat.VecDense, error) {
+	fields := parse.Split(str, ",", startColumn)
 	if len(fields) != 3 {
-		return nil, errors.New("cannot create *mat.VecDense with incorrect number of fields")
+		return nil, parse.Errorf(DAY, lineNumber, startColumn, str, "cannot create *mat.VecDense with incorrect number of fields (%v)", len(fields))
 	}
 
-	x, err := strconv.Atoi(strings.TrimSpace(fields[0]))
+	x, err := parse.Atoi(DAY, lineNumber, fields[0].TrimSpace())
 	if err != nil {
-		return nil, errors.New("failed to parse x coordinate")
+		return nil, err
 	}
 
-	y, err := strconv.Atoi(strings.TrimSpace(fields[1]))
+	y, err := parse.Atoi(DAY, lineNumber, fields[1].TrimSpace())
 	if err != nil {
-		return nil, errors.New("failed to parse y coordinate")
+		return nil, err
 	}
 
-	z, err := strconv.Atoi(strings.TrimSpace(fields[2]))
+	z, err := parse.Atoi(DAY, lineNumber, fields[2].TrimSpace())
 	if err != nil {
-		return nil, errors.New("failed to parse z coordinate")
+		return nil, err
 	}
 
 	return mat.NewVecDense(3, []float64{float64(x), float64(y), float64(z)}), nil
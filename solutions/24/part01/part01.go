@@ -6,7 +6,10 @@ import (
 )
 
 func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	storm := lib.ParseFileToStorm(fileScanner)
+	storm, err := lib.ParseFileToStorm(fileScanner)
+	if err != nil {
+		return 0, err
+	}
 	numCollisions := storm.PathIntersectionInXY(200000000000000, 400000000000000)
 
 	return numCollisions, nil
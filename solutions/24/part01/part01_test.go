@@ -0,0 +1,16 @@
+package part01
+
+import (
+	"hmcalister/aocCommon/golden"
+	"testing"
+)
+
+// The example in testdata is the one of the puzzle scaled by 1e13 and shifted by 1.3e14,
+// so its test area of 7 to 27 becomes the fixed test area of ProcessInput
+func TestProcessInput(t *testing.T) {
+	golden.TestSolver(t, ProcessInput)
+}
+
+func BenchmarkProcessInput(b *testing.B) {
+	golden.BenchmarkSolver(b, ProcessInput)
+}
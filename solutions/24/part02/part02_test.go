@@ -0,0 +1,15 @@
+package part02
+
+import (
+	"hmcalister/aocCommon/golden"
+	"testing"
+)
+
+func TestProcessInput(t *testing.T) {
+	t.Skip("no examples: part 2 is not solved yet and ProcessInput always returns 0")
+	golden.TestSolver(t, ProcessInput)
+}
+
+func BenchmarkProcessInput(b *testing.B) {
+	golden.BenchmarkSolver(b, ProcessInput)
+}
@@ -2,6 +2,9 @@ package lib
 
 import (
 	"bufio"
+	"context"
+	"errors"
+	"hmcalister/aocCommon/parse"
 	"math/rand"
 	"strings"
 
@@ -9,20 +12,30 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+// The number of wires to disconnect to split the components into two groups
+const CUT_SIZE = 3
+
+var ErrTooFewComponents = errors.New("too few components to split into two groups")
+
 type ComponentGraph struct {
 	Graph graph.Graph[string, string]
 }
 
-func ParseFileToComponentGraph(fileScanner *bufio.Scanner) *ComponentGraph {
+func ParseFileToComponentGraph(fileScanner *bufio.Scanner) (*ComponentGraph, error) {
 	newComponentGraph := &ComponentGraph{
 		Graph: graph.New(graph.StringHash),
 	}
 
 	var line string
+	lineIndex := 0
 	for fileScanner.Scan() {
 		line = fileScanner.Text()
+		lineIndex += 1
 
 		colonIndex := strings.IndexRune(line, ':')
+		if colonIndex == -1 {
+			return nil, parse.Errorf(DAY, lineIndex, 0, line, "expected a colon after the component")
+		}
 		currentComponent := line[:colonIndex]
 		neighborComponents := strings.Fields(line[colonIndex+1:])
 
@@ -39,7 +52,7 @@ func ParseFileToComponentGraph(fileScanner *bufio.Scanner) *ComponentGraph {
 		}
 	}
 
-	return newComponentGraph
+	return newComponentGraph, nil
 }
 
 func (compGraph *ComponentGraph) addComponent(componentName string) {
@@ -49,40 +62,74 @@ func (compGraph *ComponentGraph) addComponent(componentName string) {
 	}
 }
 
-func (compGraph *ComponentGraph) MinimumCut() graph.Graph[string, string] {
-	graphCopy, _ := compGraph.Graph.Clone()
-
-	var numVertices int
-	numVertices, _ = graphCopy.Order()
-
-	for numVertices > 2 {
-		allEdges, _ := graphCopy.Edges()
-		contractingEdgeIndex := rand.Int() % len(allEdges)
-		contractingEdge := allEdges[contractingEdgeIndex]
-
-		mergeLeftVertex := contractingEdge.Source
-		mergeRightVertex := contractingEdge.Target
-		graphCopy.RemoveEdge(mergeLeftVertex, mergeRightVertex)
-		adjacency, _ := graphCopy.AdjacencyMap()
+// Contract random edges until only two groups of components remain, repeating
+// until the two groups are joined by exactly cutSize edges. Returns the size of each group
+//
+// Each attempt only finds the cut with some probability, so this keeps trying until
+// the context is cancelled if no such cut exists
+func (compGraph *ComponentGraph) MinimumCut(ctx context.Context, cutSize int) (int, int, error) {
+	numVertices, _ := compGraph.Graph.Order()
+	if numVertices < 2 {
+		return 0, 0, ErrTooFewComponents
+	}
+	adjacency, _ := compGraph.Graph.AdjacencyMap()
+	vertexIndices := make(map[string]int, numVertices)
+	for vertex := range adjacency {
+		vertexIndices[vertex] = len(vertexIndices)
+	}
+	allEdges, _ := compGraph.Graph.Edges()
 
-		newVertexName := mergeLeftVertex + ", " + mergeRightVertex
-		graphCopy.AddVertex(newVertexName)
+	groups := make([]int, numVertices)
+	var findGroup func(vertexIndex int) int
+	findGroup = func(vertexIndex int) int {
+		if groups[vertexIndex] != vertexIndex {
+			groups[vertexIndex] = findGroup(groups[vertexIndex])
+		}
+		return groups[vertexIndex]
+	}
 
-		for neighbor := range adjacency[mergeLeftVertex] {
-			graphCopy.AddEdge(newVertexName, neighbor)
-			graphCopy.RemoveEdge(mergeLeftVertex, neighbor)
+	for {
+		if err := ctx.Err(); err != nil {
+			return 0, 0, err
 		}
 
-		for neighbor := range adjacency[mergeRightVertex] {
-			graphCopy.AddEdge(newVertexName, neighbor)
-			graphCopy.RemoveEdge(mergeRightVertex, neighbor)
+		for vertexIndex := range groups {
+			groups[vertexIndex] = vertexIndex
+		}
+		numGroups := numVertices
+		for _, edgeIndex := range rand.Perm(len(allEdges)) {
+			if numGroups == 2 {
+				break
+			}
+			sourceGroup := findGroup(vertexIndices[allEdges[edgeIndex].Source])
+			targetGroup := findGroup(vertexIndices[allEdges[edgeIndex].Target])
+			if sourceGroup != targetGroup {
+				groups[sourceGroup] = targetGroup
+				numGroups -= 1
+			}
+		}
+		if numGroups != 2 {
+			continue
 		}
 
-		graphCopy.RemoveVertex(mergeLeftVertex)
-		graphCopy.RemoveVertex(mergeRightVertex)
+		numCutEdges := 0
+		for _, edge := range allEdges {
+			if findGroup(vertexIndices[edge.Source]) != findGroup(vertexIndices[edge.Target]) {
+				numCutEdges += 1
+			}
+		}
+		if numCutEdges != cutSize {
+			log.Trace().Int("NumCutEdges", numCutEdges).Msg("contracted to a cut of the wrong size")
+			continue
+		}
 
-		numVertices, _ = graphCopy.Order()
+		leftGroup := findGroup(0)
+		leftSize := 0
+		for vertexIndex := range groups {
+			if findGroup(vertexIndex) == leftGroup {
+				leftSize += 1
+			}
+		}
+		return leftSize, numVertices - leftSize, nil
 	}
-
-	return graphCopy
 }
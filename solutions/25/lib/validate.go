@@ -0,0 +1,28 @@
+package lib
+
+import (
+	"bufio"
+	"hmcalister/aocCommon/parse"
+	"hmcalister/aocCommon/validate"
+	"regexp"
+)
+
+const DAY = 25
+
+// A component followed by the components it is connected to
+var componentLineRegexp = regexp.MustCompile(`^[a-z]+:( [a-z]+)+$`)
+
+// Check each line is a component and its connections, like "jqt: rhn xhk nvd"
+func ValidateInput(fileScanner *bufio.Scanner) []*parse.ParseError {
+	report := validate.NewReport(DAY)
+	lines := validate.ReadLines(fileScanner)
+
+	if len(lines) == 0 {
+		report.Addf(0, 0, "", "input is empty")
+	}
+	for lineIndex, line := range lines {
+		report.CheckPattern(line, lineIndex+1, componentLineRegexp, "jqt: rhn xhk nvd")
+	}
+
+	return report.Violations
+}
@@ -2,17 +2,31 @@ package part01
 
 import (
 	"bufio"
+	"context"
 	"hmcalister/aoc25/lib"
-	"os"
+	"hmcalister/aocCommon/answer"
 
-	"github.com/dominikbraun/graph/draw"
+	"github.com/rs/zerolog/log"
 )
 
-func ProcessInput(fileScanner *bufio.Scanner) (int, error) {
-	componentGraph := lib.ParseFileToComponentGraph(fileScanner)
-	// newGraph := componentGraph.MinimumCut()
+func ProcessInput(fileScanner *bufio.Scanner) (answer.Answer, error) {
+	return ProcessInputContext(context.Background(), fileScanner)
+}
+
+// The answer is the product of the sizes of the two groups left once the wires are cut
+func ProcessInputContext(ctx context.Context, fileScanner *bufio.Scanner) (answer.Answer, error) {
+	componentGraph, err := lib.ParseFileToComponentGraph(fileScanner)
+	if err != nil {
+		return answer.Answer{}, err
+	}
 
-	file, _ := os.Create("./graphVis.gv")
-	_ = draw.DOT(componentGraph.Graph, file)
-	return 0, nil
+	leftSize, rightSize, err := componentGraph.MinimumCut(ctx, lib.CUT_SIZE)
+	if err != nil {
+		return answer.Answer{}, err
+	}
+	log.Info().
+		Int("LeftSize", leftSize).
+		Int("RightSize", rightSize).
+		Send()
+	return answer.Int(leftSize * rightSize), nil
 }